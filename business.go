@@ -0,0 +1,242 @@
+// Copyright 2015 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+import "time"
+
+// IsWeekend reports whether d falls on a Saturday or a Sunday.
+func (d Date) IsWeekend() bool {
+	wd := d.Weekday()
+	return wd == time.Saturday || wd == time.Sunday
+}
+
+// IsWeekday reports whether d falls on a day from Monday to Friday.
+func (d Date) IsWeekday() bool {
+	return !d.IsWeekend()
+}
+
+// AddBusinessDays returns the date d plus the given number of business days
+// (Monday to Friday); a negative number of days moves backward in time.
+// The date d itself is not counted, so the result for a non-zero number
+// of days is always a business day even when d falls on a weekend
+// (e.g. one business day after a Saturday is the following Monday).
+// AddBusinessDays(0) returns d unchanged.
+func (d Date) AddBusinessDays(n int) Date {
+	if n == 0 {
+		return d
+	}
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+	// Starting from a weekend is the same as starting from the last
+	// business day before it in the direction opposite to the move
+	for d.IsWeekend() {
+		d = d.Add(-step)
+	}
+	// Every whole week contains exactly five business days
+	d = d.Add(step * 7 * (n / 5))
+	for n %= 5; n > 0; n-- {
+		d = d.Add(step)
+		for d.IsWeekend() {
+			d = d.Add(step)
+		}
+	}
+	return d
+}
+
+// BusinessSub returns the number of business days (Monday to Friday) lying
+// strictly between u and d, i.e. excluding both endpoints. The result is
+// negative if d is before u, following the sign of d-u as in Sub.
+func (d Date) BusinessSub(u Date) PeriodOfDays {
+	if d.Before(u) {
+		return -u.BusinessSub(d)
+	}
+	// Count the weekdays in [u+1,d)
+	days := d.Sub(u) - 1
+	if days <= 0 {
+		return 0
+	}
+	n := days / 7 * 5
+	for e := u.Add(1 + days/7*7); e.Before(d); e = e.Add(1) {
+		if e.IsWeekday() {
+			n++
+		}
+	}
+	return PeriodOfDays(n)
+}
+
+// standardWeekend is the Calendar used by the business day roll methods of
+// Date, with a Saturday and Sunday weekend and no holidays.
+var standardWeekend = Calendar{weekend: [7]bool{time.Saturday: true, time.Sunday: true}}
+
+// FollowingBusinessDay returns d if it is a business day (Monday to Friday),
+// or else the first business day after d. This is the "following" date
+// roll convention; see Calendar.FollowingBusinessDay to take holidays into
+// account.
+func (d Date) FollowingBusinessDay() Date {
+	return standardWeekend.FollowingBusinessDay(d)
+}
+
+// PrecedingBusinessDay returns d if it is a business day (Monday to Friday),
+// or else the last business day before d. This is the "preceding" date roll
+// convention.
+func (d Date) PrecedingBusinessDay() Date {
+	return standardWeekend.PrecedingBusinessDay(d)
+}
+
+// ModifiedFollowing returns the following business day (Monday to Friday)
+// of d, as FollowingBusinessDay does, unless that falls in a later month
+// than d, in which case it returns the preceding business day instead.
+// This is the "modified following" date roll convention.
+func (d Date) ModifiedFollowing() Date {
+	return standardWeekend.ModifiedFollowing(d)
+}
+
+// NearestBusinessDay returns d if it is a business day (Monday to Friday),
+// or else the closest business day to d, so a Saturday becomes the previous
+// Friday and a Sunday becomes the next Monday.
+func (d Date) NearestBusinessDay() Date {
+	return standardWeekend.NearestBusinessDay(d)
+}
+
+// A Calendar defines which dates are business days by specifying the days
+// of the week making up the weekend and a set of holidays.
+//
+// The zero value of type Calendar has no weekend and no holidays, so
+// every date is a business day.
+type Calendar struct {
+	weekend  [7]bool
+	holidays map[Date]bool
+}
+
+// NewCalendar returns a Calendar with the given weekend days and holidays.
+// Holidays falling on a weekend are allowed and have no additional effect.
+// NewCalendar panics if the weekend covers all seven days of the week.
+func NewCalendar(weekend []time.Weekday, holidays []Date) Calendar {
+	var c Calendar
+	n := 0
+	for _, wd := range weekend {
+		if !c.weekend[wd] {
+			c.weekend[wd] = true
+			n++
+		}
+	}
+	if n == 7 {
+		panic("date.NewCalendar: no business days in the week")
+	}
+	c.holidays = make(map[Date]bool, len(holidays))
+	for _, h := range holidays {
+		c.holidays[h] = true
+	}
+	return c
+}
+
+// IsWeekend reports whether d falls on one of the weekend days of c.
+func (c Calendar) IsWeekend(d Date) bool {
+	return c.weekend[d.Weekday()]
+}
+
+// IsHoliday reports whether d is one of the holidays of c.
+func (c Calendar) IsHoliday(d Date) bool {
+	return c.holidays[d]
+}
+
+// IsBusinessDay reports whether d is neither a weekend day nor a holiday.
+func (c Calendar) IsBusinessDay(d Date) bool {
+	return !c.weekend[d.Weekday()] && !c.holidays[d]
+}
+
+// AddBusinessDays returns the date d plus the given number of business days
+// according to c; a negative number of days moves backward in time.
+// As with Date.AddBusinessDays, d itself is not counted and
+// AddBusinessDays(d, 0) returns d unchanged.
+func (c Calendar) AddBusinessDays(d Date, n int) Date {
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+	for ; n > 0; n-- {
+		d = d.Add(step)
+		for !c.IsBusinessDay(d) {
+			d = d.Add(step)
+		}
+	}
+	return d
+}
+
+// BusinessDaysBetween returns the number of business days according to c
+// in the half-open interval [start,end). If end is before start, the result
+// is the negated number of business days in [end,start).
+func (c Calendar) BusinessDaysBetween(start, end Date) int {
+	if end.Before(start) {
+		return -c.BusinessDaysBetween(end, start)
+	}
+	perWeek := 0
+	for _, w := range c.weekend {
+		if !w {
+			perWeek++
+		}
+	}
+	days := end.Sub(start)
+	n := days / 7 * perWeek
+	for d := start.Add(days / 7 * 7); d.Before(end); d = d.Add(1) {
+		if !c.weekend[d.Weekday()] {
+			n++
+		}
+	}
+	for h := range c.holidays {
+		if !h.Before(start) && h.Before(end) && !c.weekend[h.Weekday()] {
+			n--
+		}
+	}
+	return n
+}
+
+// FollowingBusinessDay returns d if it is a business day according to c,
+// or else the first business day after d.
+func (c Calendar) FollowingBusinessDay(d Date) Date {
+	for !c.IsBusinessDay(d) {
+		d = d.Add(1)
+	}
+	return d
+}
+
+// PrecedingBusinessDay returns d if it is a business day according to c,
+// or else the last business day before d.
+func (c Calendar) PrecedingBusinessDay(d Date) Date {
+	for !c.IsBusinessDay(d) {
+		d = d.Add(-1)
+	}
+	return d
+}
+
+// ModifiedFollowing returns the following business day of d according to
+// c, unless that falls in a later month than d, in which case it returns
+// the preceding business day instead.
+func (c Calendar) ModifiedFollowing(d Date) Date {
+	u := c.FollowingBusinessDay(d)
+	if u.FirstDayOfMonth() != d.FirstDayOfMonth() {
+		return c.PrecedingBusinessDay(d)
+	}
+	return u
+}
+
+// NearestBusinessDay returns d if it is a business day according to c,
+// or else the closest business day to d. When the closest business days
+// before and after d are equally far, the later one is returned.
+func (c Calendar) NearestBusinessDay(d Date) Date {
+	if c.IsBusinessDay(d) {
+		return d
+	}
+	for n := 1; ; n++ {
+		if u := d.Add(n); c.IsBusinessDay(u) {
+			return u
+		}
+		if u := d.Add(-n); c.IsBusinessDay(u) {
+			return u
+		}
+	}
+}
@@ -0,0 +1,288 @@
+// Copyright 2015 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fxtlabs/date"
+)
+
+func TestIsWeekend(t *testing.T) {
+	// July 6, 2015 was a Monday
+	d := date.New(2015, time.July, 6)
+	for i := 0; i < 14; i++ {
+		u := d.Add(i)
+		want := u.Weekday() == time.Saturday || u.Weekday() == time.Sunday
+		if u.IsWeekend() != want {
+			t.Errorf("IsWeekend(%v) == %v, want %v", u, !want, want)
+		}
+		if u.IsWeekday() == want {
+			t.Errorf("IsWeekday(%v) == %v, want %v", u, want, !want)
+		}
+	}
+}
+
+func TestAddBusinessDays(t *testing.T) {
+	cases := []struct {
+		value date.Date
+		n     int
+		want  date.Date
+	}{
+		{date.New(2015, time.July, 8), 0, date.New(2015, time.July, 8)},
+		{date.New(2015, time.July, 11), 0, date.New(2015, time.July, 11)},
+		{date.New(2015, time.July, 8), 1, date.New(2015, time.July, 9)},
+		{date.New(2015, time.July, 8), 2, date.New(2015, time.July, 10)},
+		{date.New(2015, time.July, 8), 3, date.New(2015, time.July, 13)},
+		{date.New(2015, time.July, 8), 5, date.New(2015, time.July, 15)},
+		{date.New(2015, time.July, 8), 10, date.New(2015, time.July, 22)},
+		{date.New(2015, time.July, 10), 1, date.New(2015, time.July, 13)},
+		{date.New(2015, time.July, 11), 1, date.New(2015, time.July, 13)},
+		{date.New(2015, time.July, 12), 1, date.New(2015, time.July, 13)},
+		{date.New(2015, time.July, 11), 5, date.New(2015, time.July, 17)},
+		{date.New(2015, time.July, 12), 6, date.New(2015, time.July, 20)},
+		{date.New(2015, time.July, 8), -1, date.New(2015, time.July, 7)},
+		{date.New(2015, time.July, 6), -1, date.New(2015, time.July, 3)},
+		{date.New(2015, time.July, 11), -1, date.New(2015, time.July, 10)},
+		{date.New(2015, time.July, 12), -1, date.New(2015, time.July, 10)},
+		{date.New(2015, time.July, 12), -5, date.New(2015, time.July, 6)},
+		{date.New(2015, time.July, 8), -7, date.New(2015, time.June, 29)},
+		{date.New(2015, time.December, 31), 1, date.New(2016, time.January, 1)},
+		{date.New(2016, time.January, 1), 1, date.New(2016, time.January, 4)},
+	}
+	for _, c := range cases {
+		d := c.value.AddBusinessDays(c.n)
+		if d != c.want {
+			t.Errorf("AddBusinessDays(%v, %v) == %v, want %v", c.value, c.n, d, c.want)
+		}
+	}
+
+	// Compare against a day-by-day walk
+	start := date.New(2015, time.July, 1)
+	for i := 0; i < 14; i++ {
+		d := start.Add(i)
+		for n := -12; n <= 12; n++ {
+			want := d
+			step := 1
+			if n < 0 {
+				step = -1
+			}
+			for k := 0; k != n; k += step {
+				want = want.Add(step)
+				for want.IsWeekend() {
+					want = want.Add(step)
+				}
+			}
+			if u := d.AddBusinessDays(n); u != want {
+				t.Errorf("AddBusinessDays(%v, %v) == %v, want %v", d, n, u, want)
+			}
+		}
+	}
+}
+
+func TestBusinessSub(t *testing.T) {
+	cases := []struct {
+		d, u date.Date
+		want date.PeriodOfDays
+	}{
+		{date.New(2015, time.July, 8), date.New(2015, time.July, 8), 0},
+		{date.New(2015, time.July, 9), date.New(2015, time.July, 8), 0},
+		{date.New(2015, time.July, 10), date.New(2015, time.July, 8), 1},
+		{date.New(2015, time.July, 13), date.New(2015, time.July, 10), 0},
+		{date.New(2015, time.July, 14), date.New(2015, time.July, 10), 1},
+		{date.New(2015, time.July, 13), date.New(2015, time.July, 11), 0},
+		{date.New(2015, time.July, 19), date.New(2015, time.July, 11), 5},
+		{date.New(2015, time.July, 26), date.New(2015, time.July, 11), 10},
+		{date.New(2015, time.July, 11), date.New(2015, time.July, 26), -10},
+		{date.New(2015, time.July, 8), date.New(2015, time.July, 10), -1},
+		{date.New(2015, time.August, 1), date.New(2015, time.June, 30), 23},
+	}
+	for _, c := range cases {
+		if n := c.d.BusinessSub(c.u); n != c.want {
+			t.Errorf("BusinessSub(%v, %v) == %v, want %v", c.d, c.u, n, c.want)
+		}
+	}
+
+	// Compare against counting day by day
+	start := date.New(2015, time.July, 1)
+	for i := 0; i < 21; i++ {
+		u := start.Add(i)
+		for j := 0; j < 21; j++ {
+			d := start.Add(j)
+			var want date.PeriodOfDays
+			for e := u.Add(1); e.Before(d); e = e.Add(1) {
+				if e.IsWeekday() {
+					want++
+				}
+			}
+			for e := d.Add(1); e.Before(u); e = e.Add(1) {
+				if e.IsWeekday() {
+					want--
+				}
+			}
+			if n := d.BusinessSub(u); n != want {
+				t.Errorf("BusinessSub(%v, %v) == %v, want %v", d, u, n, want)
+			}
+		}
+	}
+}
+
+func TestBusinessDayRolls(t *testing.T) {
+	cases := []struct {
+		value                                   date.Date
+		following, preceding, modified, nearest date.Date
+	}{
+		// Wednesday
+		{date.New(2015, time.July, 15), date.New(2015, time.July, 15), date.New(2015, time.July, 15), date.New(2015, time.July, 15), date.New(2015, time.July, 15)},
+		// Saturday and Sunday
+		{date.New(2015, time.July, 11), date.New(2015, time.July, 13), date.New(2015, time.July, 10), date.New(2015, time.July, 13), date.New(2015, time.July, 10)},
+		{date.New(2015, time.July, 12), date.New(2015, time.July, 13), date.New(2015, time.July, 10), date.New(2015, time.July, 13), date.New(2015, time.July, 13)},
+		// Weekends at the end of the month
+		{date.New(2015, time.October, 31), date.New(2015, time.November, 2), date.New(2015, time.October, 30), date.New(2015, time.October, 30), date.New(2015, time.October, 30)},
+		{date.New(2015, time.May, 31), date.New(2015, time.June, 1), date.New(2015, time.May, 29), date.New(2015, time.May, 29), date.New(2015, time.June, 1)},
+		{date.New(2016, time.January, 31), date.New(2016, time.February, 1), date.New(2016, time.January, 29), date.New(2016, time.January, 29), date.New(2016, time.February, 1)},
+		// Weekends at the start of the month
+		{date.New(2015, time.August, 1), date.New(2015, time.August, 3), date.New(2015, time.July, 31), date.New(2015, time.August, 3), date.New(2015, time.July, 31)},
+	}
+	for _, c := range cases {
+		if d := c.value.FollowingBusinessDay(); d != c.following {
+			t.Errorf("FollowingBusinessDay(%v) == %v, want %v", c.value, d, c.following)
+		}
+		if d := c.value.PrecedingBusinessDay(); d != c.preceding {
+			t.Errorf("PrecedingBusinessDay(%v) == %v, want %v", c.value, d, c.preceding)
+		}
+		if d := c.value.ModifiedFollowing(); d != c.modified {
+			t.Errorf("ModifiedFollowing(%v) == %v, want %v", c.value, d, c.modified)
+		}
+		if d := c.value.NearestBusinessDay(); d != c.nearest {
+			t.Errorf("NearestBusinessDay(%v) == %v, want %v", c.value, d, c.nearest)
+		}
+	}
+}
+
+func TestCalendarBusinessDayRolls(t *testing.T) {
+	holidays := []date.Date{
+		date.New(2015, time.July, 3),      // Friday
+		date.New(2015, time.July, 15),     // Wednesday
+		date.New(2015, time.August, 31),   // Monday
+		date.New(2015, time.December, 24), // Thursday
+		date.New(2015, time.December, 25), // Friday
+		date.New(2015, time.December, 28), // Monday
+		date.New(2015, time.December, 29), // Tuesday
+		date.New(2015, time.December, 30), // Wednesday
+		date.New(2015, time.December, 31), // Thursday
+	}
+	c := date.NewCalendar([]time.Weekday{time.Saturday, time.Sunday}, holidays)
+	cases := []struct {
+		value                                   date.Date
+		following, preceding, modified, nearest date.Date
+	}{
+		{date.New(2015, time.July, 2), date.New(2015, time.July, 2), date.New(2015, time.July, 2), date.New(2015, time.July, 2), date.New(2015, time.July, 2)},
+		{date.New(2015, time.July, 3), date.New(2015, time.July, 6), date.New(2015, time.July, 2), date.New(2015, time.July, 6), date.New(2015, time.July, 2)},
+		{date.New(2015, time.July, 4), date.New(2015, time.July, 6), date.New(2015, time.July, 2), date.New(2015, time.July, 6), date.New(2015, time.July, 6)},
+		// Equally far business days on both sides
+		{date.New(2015, time.July, 15), date.New(2015, time.July, 16), date.New(2015, time.July, 14), date.New(2015, time.July, 16), date.New(2015, time.July, 16)},
+		// Holiday at the end of the month
+		{date.New(2015, time.August, 29), date.New(2015, time.September, 1), date.New(2015, time.August, 28), date.New(2015, time.August, 28), date.New(2015, time.August, 28)},
+		{date.New(2015, time.August, 31), date.New(2015, time.September, 1), date.New(2015, time.August, 28), date.New(2015, time.August, 28), date.New(2015, time.September, 1)},
+		// Holidays over the end of the year
+		{date.New(2015, time.December, 26), date.New(2016, time.January, 1), date.New(2015, time.December, 23), date.New(2015, time.December, 23), date.New(2015, time.December, 23)},
+	}
+	for _, c2 := range cases {
+		if d := c.FollowingBusinessDay(c2.value); d != c2.following {
+			t.Errorf("FollowingBusinessDay(%v) == %v, want %v", c2.value, d, c2.following)
+		}
+		if d := c.PrecedingBusinessDay(c2.value); d != c2.preceding {
+			t.Errorf("PrecedingBusinessDay(%v) == %v, want %v", c2.value, d, c2.preceding)
+		}
+		if d := c.ModifiedFollowing(c2.value); d != c2.modified {
+			t.Errorf("ModifiedFollowing(%v) == %v, want %v", c2.value, d, c2.modified)
+		}
+		if d := c.NearestBusinessDay(c2.value); d != c2.nearest {
+			t.Errorf("NearestBusinessDay(%v) == %v, want %v", c2.value, d, c2.nearest)
+		}
+	}
+}
+
+func TestCalendar(t *testing.T) {
+	holidays := []date.Date{
+		date.New(2015, time.July, 3),  // Friday
+		date.New(2015, time.July, 4),  // Saturday
+		date.New(2015, time.July, 14), // Tuesday
+		date.New(2015, time.July, 15), // Wednesday
+		date.New(2015, time.July, 16), // Thursday
+	}
+	c := date.NewCalendar([]time.Weekday{time.Saturday, time.Sunday}, holidays)
+	cases := []struct {
+		value date.Date
+		n     int
+		want  date.Date
+	}{
+		{date.New(2015, time.July, 2), 0, date.New(2015, time.July, 2)},
+		{date.New(2015, time.July, 2), 1, date.New(2015, time.July, 6)},
+		{date.New(2015, time.July, 6), -1, date.New(2015, time.July, 2)},
+		{date.New(2015, time.July, 4), 1, date.New(2015, time.July, 6)},
+		{date.New(2015, time.July, 13), 1, date.New(2015, time.July, 17)},
+		{date.New(2015, time.July, 13), 2, date.New(2015, time.July, 20)},
+		{date.New(2015, time.July, 17), -1, date.New(2015, time.July, 13)},
+		{date.New(2015, time.July, 1), 10, date.New(2015, time.July, 21)},
+	}
+	for _, c2 := range cases {
+		d := c.AddBusinessDays(c2.value, c2.n)
+		if d != c2.want {
+			t.Errorf("AddBusinessDays(%v, %v) == %v, want %v", c2.value, c2.n, d, c2.want)
+		}
+	}
+
+	if c.IsBusinessDay(date.New(2015, time.July, 3)) {
+		t.Errorf("IsBusinessDay(2015-07-03) == true, want false")
+	}
+	if !c.IsBusinessDay(date.New(2015, time.July, 2)) {
+		t.Errorf("IsBusinessDay(2015-07-02) == false, want true")
+	}
+
+	between := []struct {
+		start, end date.Date
+		want       int
+	}{
+		{date.New(2015, time.July, 1), date.New(2015, time.July, 1), 0},
+		{date.New(2015, time.July, 1), date.New(2015, time.July, 2), 1},
+		{date.New(2015, time.July, 1), date.New(2015, time.July, 8), 4},
+		{date.New(2015, time.July, 8), date.New(2015, time.July, 1), -4},
+		{date.New(2015, time.July, 1), date.New(2015, time.August, 1), 19},
+	}
+	for _, b := range between {
+		n := c.BusinessDaysBetween(b.start, b.end)
+		if n != b.want {
+			t.Errorf("BusinessDaysBetween(%v, %v) == %v, want %v", b.start, b.end, n, b.want)
+		}
+	}
+}
+
+func TestCalendarWeekend(t *testing.T) {
+	// Friday-Saturday weekend with no holidays
+	c := date.NewCalendar([]time.Weekday{time.Friday, time.Saturday}, nil)
+	d := date.New(2015, time.July, 9) // Thursday
+	if u := c.AddBusinessDays(d, 1); u != date.New(2015, time.July, 12) {
+		t.Errorf("AddBusinessDays(%v, 1) == %v, want 2015-07-12", d, u)
+	}
+	if c.IsWeekend(date.New(2015, time.July, 12)) {
+		t.Errorf("IsWeekend(2015-07-12) == true, want false")
+	}
+
+	// The zero calendar counts every day
+	var z date.Calendar
+	if n := z.BusinessDaysBetween(d, d.Add(30)); n != 30 {
+		t.Errorf("BusinessDaysBetween(%v, %v) == %v, want 30", d, d.Add(30), n)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("NewCalendar with a seven-day weekend did not panic")
+		}
+	}()
+	date.NewCalendar([]time.Weekday{0, 1, 2, 3, 4, 5, 6}, nil)
+}
@@ -73,6 +73,10 @@ type Date struct {
 	day int32
 }
 
+// PeriodOfDays describes a period of time measured in whole days. Negative
+// values indicate days earlier than some mark.
+type PeriodOfDays int32
+
 // New returns the Date value corresponding to the given year, month, and day.
 //
 // The month and day may be outside their usual ranges and will be normalized
@@ -82,6 +86,19 @@ func New(year int, month time.Month, day int) Date {
 	return Date{encode(t)}
 }
 
+// NewValid returns the Date value corresponding to the given year, month,
+// and day, like New, but returns an error instead of normalizing the month
+// or the day if they are outside their valid ranges (e.g. February 30).
+func NewValid(year int, month time.Month, day int) (Date, error) {
+	if month < time.January || month > time.December {
+		return Date{}, fmt.Errorf("Date.NewValid: month %d out of range", month)
+	}
+	if day < 1 || day > DaysIn(year, month) {
+		return Date{}, fmt.Errorf("Date.NewValid: day %d out of range for %v %d", day, month, year)
+	}
+	return New(year, month, day), nil
+}
+
 // NewAt returns the Date value corresponding to the given time.
 // Note that the date is computed relative to the time zone specified by
 // the given Time value.
@@ -89,22 +106,48 @@ func NewAt(t time.Time) Date {
 	return Date{encode(t)}
 }
 
+// FromYearDay returns the Date value corresponding to the given day of the
+// given year, where day 1 is January 1. This is the inverse of YearDay.
+//
+// The day may be outside its usual range and will be normalized
+// during the conversion (e.g. day 0 is December 31 of the previous year).
+func FromYearDay(year, day int) Date {
+	return New(year, time.January, day)
+}
+
+// FromDaysSinceEpoch returns the Date value corresponding to the given number
+// of days elapsed since January 1, 1970 (the Unix epoch); negative values
+// give dates before the epoch. This is the inverse of DaysSinceEpoch.
+func FromDaysSinceEpoch(days int32) Date {
+	return Date{days}
+}
+
+// Now is the function used by Today, TodayUTC, TodayIn, and the predicates
+// built on them (e.g. IsToday) to get the current time; it defaults to
+// time.Now.
+//
+// Now is meant to be replaced only by tests that need a deterministic clock,
+// and only while no other goroutine is using this package; calling Today and
+// the related functions concurrently is safe as long as Now is not being
+// replaced at the same time.
+var Now = time.Now
+
 // Today returns today's date according to the current local time.
 func Today() Date {
-	t := time.Now()
+	t := Now().Local()
 	return Date{encode(t)}
 }
 
 // TodayUTC returns today's date according to the current UTC time.
 func TodayUTC() Date {
-	t := time.Now().UTC()
+	t := Now().UTC()
 	return Date{encode(t)}
 }
 
 // TodayIn returns today's date according to the current time relative to
 // the specified location.
 func TodayIn(loc *time.Location) Date {
-	t := time.Now().In(loc)
+	t := Now().In(loc)
 	return Date{encode(t)}
 }
 
@@ -118,6 +161,66 @@ func Max() Date {
 	return Date{math.MaxInt32}
 }
 
+// DaysIn returns the number of days in the given month of the given year.
+// The month may be outside its usual range and will be normalized
+// (e.g. month 13 of 2015 is January 2016).
+func DaysIn(year int, month time.Month) int {
+	// Day 0 of the next month normalizes to the last day of this month
+	t := time.Date(year, month+1, 0, 12, 0, 0, 0, time.UTC)
+	return t.Day()
+}
+
+// IsLeapYear reports whether the given year is a leap year under the
+// proleptic Gregorian calendar, i.e. whether it is divisible by 4 but not
+// by 100, unless it is also divisible by 400. Since the calendar uses
+// astronomical year numbering, year 0 (1 BC) and year -4 (5 BC) are leap
+// years.
+func IsLeapYear(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+// Earliest returns the earlier of the two dates a and b.
+func Earliest(a, b Date) Date {
+	if b.Before(a) {
+		return b
+	}
+	return a
+}
+
+// Latest returns the later of the two dates a and b.
+func Latest(a, b Date) Date {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
+
+// MinOf returns the earliest of the given dates.
+// It panics if no dates are given.
+func MinOf(dates ...Date) Date {
+	if len(dates) == 0 {
+		panic("date.MinOf: no dates")
+	}
+	m := dates[0]
+	for _, d := range dates[1:] {
+		m = Earliest(m, d)
+	}
+	return m
+}
+
+// MaxOf returns the latest of the given dates.
+// It panics if no dates are given.
+func MaxOf(dates ...Date) Date {
+	if len(dates) == 0 {
+		panic("date.MaxOf: no dates")
+	}
+	m := dates[0]
+	for _, d := range dates[1:] {
+		m = Latest(m, d)
+	}
+	return m
+}
+
 // UTC returns a Time value corresponding to midnight on the given date,
 // UTC time.  Note that midnight is the beginning of the day rather than the end.
 func (d Date) UTC() time.Time {
@@ -133,10 +236,91 @@ func (d Date) Local() time.Time {
 // In returns a Time value corresponding to midnight on the given date,
 // relative to the specified time zone.  Note that midnight is the beginning
 // of the day rather than the end.
+//
+// If a zone transition (e.g. the start of daylight saving time) skips
+// midnight, In returns the first instant of the day instead (e.g. 01:00).
+// If midnight occurs twice, In returns the earlier of the two instants.
+// If the whole day is skipped (as when a zone moves across the
+// International Date Line), In returns the first instant after it.
 func (d Date) In(loc *time.Location) time.Time {
-	t := decode(d.day).In(loc)
-	_, offset := t.Zone()
-	return t.Add(time.Duration(-offset) * time.Second)
+	year, month, day := d.Date()
+	t := time.Date(year, month, day, 0, 0, 0, 0, loc)
+	if NewAt(t).Before(d) {
+		// Midnight falls in a gap and time.Date resolved it to an
+		// instant on an earlier day; the day starts at the transition
+		if _, end := t.ZoneBounds(); !end.IsZero() {
+			t = end
+		}
+	} else if start, _ := t.ZoneBounds(); !start.IsZero() {
+		// Midnight may also occur in the previous zone period if the
+		// transition set the clocks back past it
+		_, offset := t.Zone()
+		_, prevOffset := start.Add(-time.Nanosecond).Zone()
+		u := t.Add(time.Duration(offset-prevOffset) * time.Second)
+		if u.Before(start) && NewAt(u) == d {
+			t = u
+		}
+	}
+	return t
+}
+
+// StartOfDay returns the first instant of the given date relative to the
+// specified time zone; it is the same as In.
+func (d Date) StartOfDay(loc *time.Location) time.Time {
+	return d.In(loc)
+}
+
+// EndOfDay returns the last instant (with nanosecond precision) of the given
+// date relative to the specified time zone, e.g. 23:59:59.999999999.
+// For range queries, prefer the half-open interval returned by DayBounds.
+func (d Date) EndOfDay(loc *time.Location) time.Time {
+	return d.Add(1).In(loc).Add(-time.Nanosecond)
+}
+
+// DayBounds returns the half-open interval [start,end) of instants falling
+// on the given date relative to the specified time zone, where end is the
+// start of the following day. The interval lasts 24 hours except on days
+// affected by a zone transition, which may last 23 or 25 hours instead
+// (or be empty when the zone skips the whole day).
+func (d Date) DayBounds(loc *time.Location) (start, end time.Time) {
+	return d.In(loc), d.Add(1).In(loc)
+}
+
+// At returns the Time value corresponding to the given clock time on the
+// given date, relative to the specified time zone. It is the inverse of
+// NewAt for the date part of a Time value.
+//
+// As with time.Date, the hour, min, sec, and nsec values may be outside
+// their usual ranges and will be normalized during the conversion, so
+// At(24, 0, 0, 0, loc) returns midnight at the start of the following day.
+// If the clock time does not exist in loc because of a zone transition
+// (e.g. 02:30 on the day daylight saving time starts), or occurs twice,
+// At returns a time that is correct in one of the two zones involved in
+// the transition, but it does not guarantee which; see time.Date.
+func (d Date) At(hour, min, sec, nsec int, loc *time.Location) time.Time {
+	year, month, day := d.Date()
+	return time.Date(year, month, day, hour, min, sec, nsec, loc)
+}
+
+// ToTimes returns the Time values corresponding to midnight on each of the
+// given dates, relative to the specified time zone, as returned by In.
+func ToTimes(dates []Date, loc *time.Location) []time.Time {
+	times := make([]time.Time, len(dates))
+	for i, d := range dates {
+		times[i] = d.In(loc)
+	}
+	return times
+}
+
+// FromTimes returns the dates of the given Time values, as returned by
+// NewAt. Each date is computed relative to the time zone of its own Time
+// value, so the civil date of each value is preserved.
+func FromTimes(times []time.Time) []Date {
+	dates := make([]Date, len(times))
+	for i, t := range times {
+		dates[i] = NewAt(t)
+	}
+	return dates
 }
 
 // Date returns the year, month, and day of d.
@@ -171,6 +355,14 @@ func (d Date) YearDay() int {
 	return t.YearDay()
 }
 
+// OrdinalDate returns the year and the day of the year specified by d,
+// which together form the ISO 8601 ordinal date (e.g. 2015-192).
+// It is equivalent to calling Year and YearDay, but decodes d only once.
+func (d Date) OrdinalDate() (year, dayOfYear int) {
+	t := decode(d.day)
+	return t.Year(), t.YearDay()
+}
+
 // Weekday returns the day of the week specified by d.
 func (d Date) Weekday() time.Weekday {
 	// Date zero, January 1, 1970, fell on a Thursday
@@ -188,6 +380,87 @@ func (d Date) ISOWeek() (year, week int) {
 	return t.ISOWeek()
 }
 
+// FirstDayOfMonth returns the first day of the month containing d.
+func (d Date) FirstDayOfMonth() Date {
+	year, month, _ := d.Date()
+	return New(year, month, 1)
+}
+
+// LastDayOfMonth returns the last day of the month containing d.
+func (d Date) LastDayOfMonth() Date {
+	year, month, _ := d.Date()
+	// Day 0 of the next month normalizes to the last day of this month
+	return New(year, month+1, 0)
+}
+
+// DaysInMonth returns the number of days in the month containing d.
+func (d Date) DaysInMonth() int {
+	year, month, _ := d.Date()
+	return DaysIn(year, month)
+}
+
+// IsLeapYear reports whether d falls in a leap year.
+func (d Date) IsLeapYear() bool {
+	return IsLeapYear(d.Year())
+}
+
+// Quarter returns the calendar quarter in which d occurs, in the range [1,4].
+// The first quarter runs from January to March, the second from April to
+// June, and so on.
+func (d Date) Quarter() int {
+	return (int(d.Month())-1)/3 + 1
+}
+
+// FirstDayOfQuarter returns the first day of the quarter containing d.
+func (d Date) FirstDayOfQuarter() Date {
+	year, month, _ := d.Date()
+	return New(year, month-(month-1)%3, 1)
+}
+
+// LastDayOfQuarter returns the last day of the quarter containing d.
+func (d Date) LastDayOfQuarter() Date {
+	year, month, _ := d.Date()
+	return New(year, month-(month-1)%3+3, 0)
+}
+
+// FiscalQuarter returns the fiscal year and the fiscal quarter, in the range
+// [1,4], in which d occurs for a fiscal year starting on the first day of
+// the given month. The fiscal year is labelled with the calendar year in
+// which it starts; for example, with fiscal years starting in April,
+// January 15, 2016 falls in the fourth quarter of fiscal year 2015.
+// With fiscal years starting in January, FiscalQuarter returns the same
+// values as Year and Quarter.
+// FiscalQuarter panics if the month is not in the range [1,12].
+func (d Date) FiscalQuarter(fyStartMonth time.Month) (fiscalYear, quarter int) {
+	if fyStartMonth < time.January || fyStartMonth > time.December {
+		panic(fmt.Sprintf("date.FiscalQuarter: invalid month %d", fyStartMonth))
+	}
+	year, month, _ := d.Date()
+	months := int(month - fyStartMonth)
+	if months < 0 {
+		year--
+		months += 12
+	}
+	return year, months/3 + 1
+}
+
+// DaysSinceEpoch returns the number of days elapsed from January 1, 1970
+// (the Unix epoch) to d, as counted between midnights UTC; the result is
+// negative for dates before the epoch. This is the same day count used by
+// many other systems (e.g. the Apache Arrow date32 type).
+func (d Date) DaysSinceEpoch() int32 {
+	return d.day
+}
+
+// Key returns a compact key identifying d, suitable for use in caches, hash
+// functions and bitmaps. Keys preserve the order of dates, so Min has key 0,
+// Max has key math.MaxUint32, and d is before u if and only if d.Key() is
+// less than u.Key(). Keys do not depend on the platform or on the process,
+// so they are stable enough to be persisted.
+func (d Date) Key() uint32 {
+	return uint32(d.day) ^ 1<<31
+}
+
 // IsZero reports whether t represents the zero date.
 func (d Date) IsZero() bool {
 	return d.day == 0
@@ -208,26 +481,302 @@ func (d Date) After(u Date) bool {
 	return d.day > u.day
 }
 
+// SameYear reports whether d and u fall in the same calendar year.
+func (d Date) SameYear(u Date) bool {
+	return d.Year() == u.Year()
+}
+
+// SameMonth reports whether d and u fall in the same month of the same
+// calendar year.
+func (d Date) SameMonth(u Date) bool {
+	return d.FirstDayOfMonth() == u.FirstDayOfMonth()
+}
+
+// SameISOWeek reports whether d and u fall in the same ISO 8601 week, as
+// given by ISOWeek; this compares the ISO week-numbering years, so
+// December 31, 2018 and January 1, 2019 share week 1 of 2019, while
+// January 1, 2021 belongs to week 53 of 2020.
+func (d Date) SameISOWeek(u Date) bool {
+	dy, dw := d.ISOWeek()
+	uy, uw := u.ISOWeek()
+	return dy == uy && dw == uw
+}
+
+// IsToday reports whether d is today's date according to the current
+// local time.
+func (d Date) IsToday() bool {
+	return d == Today()
+}
+
+// IsTodayUTC reports whether d is today's date according to the current
+// UTC time.
+func (d Date) IsTodayUTC() bool {
+	return d == TodayUTC()
+}
+
+// IsTodayIn reports whether d is today's date according to the current
+// time relative to the specified location.
+func (d Date) IsTodayIn(loc *time.Location) bool {
+	return d == TodayIn(loc)
+}
+
+// IsPast reports whether d is before today's date according to the current
+// local time.
+func (d Date) IsPast() bool {
+	return d.Before(Today())
+}
+
+// IsPastUTC reports whether d is before today's date according to the
+// current UTC time.
+func (d Date) IsPastUTC() bool {
+	return d.Before(TodayUTC())
+}
+
+// IsFuture reports whether d is after today's date according to the current
+// local time.
+func (d Date) IsFuture() bool {
+	return d.After(Today())
+}
+
+// IsFutureUTC reports whether d is after today's date according to the
+// current UTC time.
+func (d Date) IsFutureUTC() bool {
+	return d.After(TodayUTC())
+}
+
+// Compare compares the date d with u. If d is before u, it returns -1;
+// if d is after u, it returns +1; if they're the same, it returns 0.
+func (d Date) Compare(u Date) int {
+	switch {
+	case d.day < u.day:
+		return -1
+	case d.day > u.day:
+		return +1
+	}
+	return 0
+}
+
+// Between reports whether d falls within the closed interval [start,end],
+// i.e. start and end are both included. The order of start and end does
+// not matter.
+func (d Date) Between(start, end Date) bool {
+	if end.Before(start) {
+		start, end = end, start
+	}
+	return !d.Before(start) && !d.After(end)
+}
+
+// BetweenExclusive reports whether d falls within the half-open interval
+// [start,end), i.e. start is included and end is not. If end is before
+// start, the two dates are swapped first, so that the earlier date is
+// always included and the later one is always excluded.
+func (d Date) BetweenExclusive(start, end Date) bool {
+	if end.Before(start) {
+		start, end = end, start
+	}
+	return !d.Before(start) && d.Before(end)
+}
+
+// Clamp returns min if d is before min, max if d is after max, and d
+// otherwise. If min is after max, Clamp returns min.
+func (d Date) Clamp(min, max Date) Date {
+	if !d.After(min) || min.After(max) {
+		return min
+	}
+	if d.After(max) {
+		return max
+	}
+	return d
+}
+
 // Add returns the date d plus the given number of days.
 func (d Date) Add(days int) Date {
 	return Date{d.day + int32(days)}
 }
 
+// AddChecked returns the date d plus the given number of days, like Add,
+// and whether the result is within the range of representable dates
+// [Min(),Max()]. If it is not, the returned date is not meaningful.
+func (d Date) AddChecked(days PeriodOfDays) (Date, bool) {
+	sum := int64(d.day) + int64(days)
+	return Date{int32(sum)}, math.MinInt32 <= sum && sum <= math.MaxInt32
+}
+
 // AddDate returns the date corresponding to adding the given number of years,
 // months, and days to d. For example, AddData(-1, 2, 3) applied to
 // January 1, 2011 returns March 4, 2010.
+// If the result falls outside the range of representable dates, it wraps
+// around silently; use AddDateChecked to detect this.
 func (d Date) AddDate(years, months, days int) Date {
 	t := decode(d.day)
 	t = t.AddDate(years, months, days)
 	return Date{encode(t)}
 }
 
+// AddDateChecked returns the date corresponding to adding the given number
+// of years, months, and days to d, like AddDate, and whether the result is
+// within the range of representable dates [Min(),Max()]. If it is not, the
+// returned date is not meaningful.
+func (d Date) AddDateChecked(years, months, days int) (Date, bool) {
+	t := decode(d.day).AddDate(years, months, days)
+	// t is still at midnight UTC, so the division is exact
+	n := t.Unix() / secondsPerDay
+	return Date{int32(n)}, math.MinInt32 <= n && n <= math.MaxInt32
+}
+
+// AddWeeks returns the date d plus the given number of weeks.
+// Negative values of weeks move backward in time.
+func (d Date) AddWeeks(weeks int) Date {
+	return d.Add(weeks * 7)
+}
+
+// SubWeeks returns the date d minus the given number of weeks.
+// It is equivalent to d.AddWeeks(-weeks).
+func (d Date) SubWeeks(weeks int) Date {
+	return d.Add(-weeks * 7)
+}
+
+// AddYears returns the date corresponding to adding the given number of
+// years to d. It is equivalent to d.AddDate(years, 0, 0), so February 29
+// becomes March 1 when the target year is not a leap year.
+func (d Date) AddYears(years int) Date {
+	return d.AddDate(years, 0, 0)
+}
+
+// AddMonths returns the date corresponding to adding the given number of
+// months to d. If the day of d does not exist in the target month, the
+// result depends on clamp:
+//
+// If clamp is false, the result overflows into the following month as with
+// AddDate; for example, adding one month to January 31, 2015 returns
+// March 3, 2015.
+//
+// If clamp is true, the result is pinned to the last day of the target
+// month; for example, adding one month to January 31, 2015 returns
+// February 28, 2015, and adding one month to January 31, 2016 returns
+// February 29, 2016.
+func (d Date) AddMonths(months int, clamp bool) Date {
+	if !clamp {
+		return d.AddDate(0, months, 0)
+	}
+	year, month, day := d.Date()
+	month += time.Month(months)
+	if n := DaysIn(year, month); day > n {
+		day = n
+	}
+	return New(year, month, day)
+}
+
+// PlusMonthsSQL returns the date corresponding to adding the given number
+// of months to d with the semantics of adding a month interval to a date in
+// standard SQL (e.g. DATE '2015-01-31' + INTERVAL '1' MONTH in PostgreSQL):
+// if the day of d does not exist in the target month, the result is the
+// last day of that month. It is the same as AddMonths(months, true).
+//
+// By contrast, AddDate follows time.AddDate and overflows into the
+// following month: adding one month to January 31, 2015 with AddDate
+// returns March 3, 2015, whereas PlusMonthsSQL returns February 28, 2015.
+func (d Date) PlusMonthsSQL(months int) Date {
+	return d.AddMonths(months, true)
+}
+
+// WithYear returns the date with the same month and day as d but in the
+// given year. As with New, the result is normalized if that day does not
+// exist in the given year, so February 29 becomes March 1 in non-leap years.
+func (d Date) WithYear(year int) Date {
+	_, month, day := d.Date()
+	return New(year, month, day)
+}
+
+// WithMonth returns the date with the same year and day as d but in the
+// given month. As with New, the month and day may be outside their usual
+// ranges and the result is normalized, so setting the month of May 31 to
+// June gives July 1.
+func (d Date) WithMonth(month time.Month) Date {
+	year, _, day := d.Date()
+	return New(year, month, day)
+}
+
+// WithDay returns the date with the same year and month as d but with the
+// given day of the month. As with New, the day may be outside its usual
+// range and the result is normalized, so setting the day of a February
+// date to 31 rolls over into March (e.g. March 3 in non-leap years).
+func (d Date) WithDay(day int) Date {
+	year, month, _ := d.Date()
+	return New(year, month, day)
+}
+
 // Sub returns d-u as the number of days between the two dates.
 func (d Date) Sub(u Date) (days int) {
 	return int(d.day - u.day)
 }
 
+// DaysUntil returns the number of days from d until u; the result is
+// negative if u is before d. It is the same as u.Sub(d).
+func (d Date) DaysUntil(u Date) int {
+	return u.Sub(d)
+}
+
+// DaysSince returns the number of days from u until d; the result is
+// negative if u is after d. It is the same as d.Sub(u).
+func (d Date) DaysSince(u Date) int {
+	return d.Sub(u)
+}
+
+// DiffInMonths returns the number of whole calendar months elapsed from u
+// to d; the result is negative if d is before u, following the sign of d-u
+// as in Sub. A month is complete when the day of the month of u is reached,
+// so there is no whole month from January 15 to February 14, and none from
+// January 31 to February 28 (or 29) either.
+func (d Date) DiffInMonths(u Date) int {
+	if d.Before(u) {
+		return -u.DiffInMonths(d)
+	}
+	y1, m1, d1 := u.Date()
+	y2, m2, d2 := d.Date()
+	months := (y2-y1)*12 + int(m2-m1)
+	if d2 < d1 {
+		months--
+	}
+	return months
+}
+
+// DiffInYears returns the number of whole calendar years elapsed from u to d;
+// the result is negative if d is before u. It follows the same rules as
+// DiffInMonths and, for u before d, equals u.Age(d).
+func (d Date) DiffInYears(u Date) int {
+	return d.DiffInMonths(u) / 12
+}
+
+// SubChecked returns d-u as the number of days between the two dates, like
+// Sub, and whether the result fits in a PeriodOfDays. If it does not, the
+// returned number of days is not meaningful.
+func (d Date) SubChecked(u Date) (PeriodOfDays, bool) {
+	diff := int64(d.day) - int64(u.day)
+	return PeriodOfDays(diff), math.MinInt32 <= diff && diff <= math.MaxInt32
+}
+
+// Age returns the number of whole years elapsed from d to asOf, as when
+// computing the age on asOf of a person born on d. The count increases on
+// each anniversary of d; someone born on February 29 becomes a year older
+// on March 1 in non-leap years. If asOf is before d, the result is the
+// negated number of whole years elapsed from asOf to d.
+func (d Date) Age(asOf Date) int {
+	if asOf.Before(d) {
+		return -asOf.Age(d)
+	}
+	y1, m1, d1 := d.Date()
+	y2, m2, d2 := asOf.Date()
+	years := y2 - y1
+	if m2 < m1 || (m2 == m1 && d2 < d1) {
+		years--
+	}
+	return years
+}
+
 // MarshalBinary implements the encoding.BinaryMarshaler interface.
+// The date is encoded as the 4-byte big-endian number of days elapsed
+// since date zero.
 func (d Date) MarshalBinary() ([]byte, error) {
 	enc := []byte{
 		byte(d.day >> 24),
@@ -239,6 +788,7 @@ func (d Date) MarshalBinary() ([]byte, error) {
 }
 
 // UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+// The data must be exactly 4 bytes long, as produced by MarshalBinary.
 func (d *Date) UnmarshalBinary(data []byte) error {
 	if len(data) == 0 {
 		return errors.New("Date.UnmarshalBinary: no data")
@@ -253,6 +803,7 @@ func (d *Date) UnmarshalBinary(data []byte) error {
 }
 
 // GobEncode implements the gob.GobEncoder interface.
+// The date is encoded in the same compact 4-byte form used by MarshalBinary.
 func (d Date) GobEncode() ([]byte, error) {
 	return d.MarshalBinary()
 }
@@ -277,8 +828,13 @@ func (d Date) MarshalJSON() ([]byte, error) {
 // (e.g. "2006-01-02", "+12345-06-07", "-0987-06-05");
 // the year must use at least 4 digits and if outside the [0,9999] range
 // must be prefixed with a + or - sign.
+// The JSON null value leaves the date unchanged.
 func (d *Date) UnmarshalJSON(data []byte) (err error) {
 	value := string(data)
+	// As in the standard library, the JSON null value is a no-op
+	if value == "null" {
+		return nil
+	}
 	n := len(value)
 	if n < 2 || value[0] != '"' || value[n-1] != '"' {
 		return fmt.Errorf("Date.UnmarshalJSON: missing double quotes (%s)", value)
@@ -314,3 +870,27 @@ func (d *Date) UnmarshalText(data []byte) error {
 	d.day = u.day
 	return nil
 }
+
+// MarshalCSV returns the date in ISO 8601 extended format, as MarshalText
+// does. Together with UnmarshalCSV, it implements the interfaces used by
+// CSV struct mapping libraries such as github.com/gocarina/gocsv.
+func (d Date) MarshalCSV() (string, error) {
+	return d.String(), nil
+}
+
+// UnmarshalCSV sets the date from a CSV field in any format accepted by
+// ParseISO. An empty field sets the date to the zero date (January 1,
+// 1970), so optional columns can be left blank; use NullDate if a blank
+// field must be told apart from the zero date.
+func (d *Date) UnmarshalCSV(value string) error {
+	if value == "" {
+		d.day = 0
+		return nil
+	}
+	u, err := ParseISO(value)
+	if err != nil {
+		return err
+	}
+	d.day = u.day
+	return nil
+}
@@ -6,10 +6,14 @@ package date_test
 
 import (
 	"bytes"
+	"encoding/csv"
 	"encoding/gob"
 	"encoding/json"
+	"math"
+	"strings"
 	"testing"
 	"time"
+	_ "time/tzdata"
 
 	"github.com/fxtlabs/date"
 )
@@ -54,6 +58,146 @@ func TestNew(t *testing.T) {
 	}
 }
 
+func TestNewValid(t *testing.T) {
+	cases := []struct {
+		year  int
+		month time.Month
+		day   int
+		want  string
+	}{
+		{2015, time.January, 1, ""},
+		{2015, time.January, 31, ""},
+		{2015, time.December, 31, ""},
+		{2015, time.April, 30, ""},
+		{2016, time.February, 29, ""},
+		{2000, time.February, 29, ""},
+		{0, time.February, 29, ""},
+		{-4, time.February, 29, ""},
+		{2015, 0, 1, "Date.NewValid: month 0 out of range"},
+		{2015, 13, 1, "Date.NewValid: month 13 out of range"},
+		{2015, time.January, 0, "Date.NewValid: day 0 out of range for January 2015"},
+		{2015, time.January, 32, "Date.NewValid: day 32 out of range for January 2015"},
+		{2015, time.April, 31, "Date.NewValid: day 31 out of range for April 2015"},
+		{2021, time.February, 30, "Date.NewValid: day 30 out of range for February 2021"},
+		{2015, time.February, 29, "Date.NewValid: day 29 out of range for February 2015"},
+		{1900, time.February, 29, "Date.NewValid: day 29 out of range for February 1900"},
+		{-1, time.February, 29, "Date.NewValid: day 29 out of range for February -1"},
+	}
+	for _, c := range cases {
+		d, err := date.NewValid(c.year, c.month, c.day)
+		if c.want == "" {
+			if err != nil {
+				t.Errorf("NewValid(%v, %v, %v) error %v", c.year, c.month, c.day, err)
+			} else if d != date.New(c.year, c.month, c.day) {
+				t.Errorf("NewValid(%v, %v, %v) == %v", c.year, c.month, c.day, d)
+			}
+		} else if err == nil || err.Error() != c.want {
+			t.Errorf("NewValid(%v, %v, %v) == %v (%v), want %v", c.year, c.month, c.day, d, err, c.want)
+		}
+	}
+}
+
+func TestFromYearDay(t *testing.T) {
+	cases := []struct {
+		year, day int
+		want      date.Date
+	}{
+		{2015, 1, date.New(2015, time.January, 1)},
+		{2015, 192, date.New(2015, time.July, 11)},
+		{2015, 365, date.New(2015, time.December, 31)},
+		{2016, 366, date.New(2016, time.December, 31)},
+		{2015, 366, date.New(2016, time.January, 1)},
+		{2015, 0, date.New(2014, time.December, 31)},
+		{-1, 60, date.New(-1, time.March, 1)},
+		{0, 60, date.New(0, time.February, 29)},
+	}
+	for _, c := range cases {
+		d := date.FromYearDay(c.year, c.day)
+		if d != c.want {
+			t.Errorf("FromYearDay(%v, %v) == %v, want %v", c.year, c.day, d, c.want)
+		}
+		if c.day >= 1 && d.Year() == c.year && d.YearDay() != c.day {
+			t.Errorf("YearDay(%v) == %v, want %v", d, d.YearDay(), c.day)
+		}
+	}
+}
+
+func TestOrdinalDate(t *testing.T) {
+	cases := []struct {
+		value     date.Date
+		year, day int
+	}{
+		{date.New(2015, time.January, 1), 2015, 1},
+		{date.New(2015, time.July, 11), 2015, 192},
+		{date.New(2015, time.December, 31), 2015, 365},
+		{date.New(2016, time.December, 31), 2016, 366},
+		{date.New(0, time.February, 29), 0, 60},
+		{date.New(-1, time.March, 1), -1, 60},
+		{date.Min(), -5877641, 174},
+		{date.Max(), 5881580, 193},
+	}
+	for _, c := range cases {
+		year, day := c.value.OrdinalDate()
+		if year != c.year || day != c.day {
+			t.Errorf("OrdinalDate(%v) == (%v, %v), want (%v, %v)", c.value, year, day, c.year, c.day)
+		}
+		if year != c.value.Year() || day != c.value.YearDay() {
+			t.Errorf("OrdinalDate(%v) == (%v, %v), want (%v, %v)", c.value, year, day, c.value.Year(), c.value.YearDay())
+		}
+		if d := date.FromYearDay(year, day); d != c.value {
+			t.Errorf("FromYearDay(OrdinalDate(%v)) == %v, want %v", c.value, d, c.value)
+		}
+	}
+}
+
+func TestDaysSinceEpoch(t *testing.T) {
+	cases := []struct {
+		value date.Date
+		days  int32
+	}{
+		{date.Min(), -2147483648},
+		{date.New(1969, time.December, 31), -1},
+		{date.New(1970, time.January, 1), 0},
+		{date.New(1970, time.January, 2), 1},
+		{date.New(2000, time.January, 1), 10957},
+		{date.New(2015, time.July, 11), 16627},
+		{date.Max(), 2147483647},
+	}
+	for _, c := range cases {
+		if days := c.value.DaysSinceEpoch(); days != c.days {
+			t.Errorf("DaysSinceEpoch(%v) == %v, want %v", c.value, days, c.days)
+		}
+		if d := date.FromDaysSinceEpoch(c.days); d != c.value {
+			t.Errorf("FromDaysSinceEpoch(%v) == %v, want %v", c.days, d, c.value)
+		}
+		if secs := c.value.UTC().Unix(); secs != int64(c.days)*24*60*60 {
+			t.Errorf("UTC(%v).Unix() == %v, want %v", c.value, secs, int64(c.days)*24*60*60)
+		}
+	}
+}
+
+func TestKey(t *testing.T) {
+	cases := []struct {
+		value date.Date
+		key   uint32
+	}{
+		{date.Min(), 0},
+		{date.Min().Add(1), 1},
+		{date.New(1969, time.December, 31), 1<<31 - 1},
+		{date.New(1970, time.January, 1), 1 << 31},
+		{date.New(2015, time.July, 11), 1<<31 + 16627},
+		{date.Max(), 1<<32 - 1},
+	}
+	for i, c := range cases {
+		if k := c.value.Key(); k != c.key {
+			t.Errorf("Key(%v) == %v, want %v", c.value, k, c.key)
+		}
+		if i > 0 && cases[i-1].value.Key() >= c.value.Key() {
+			t.Errorf("Key(%v) >= Key(%v)", cases[i-1].value, c.value)
+		}
+	}
+}
+
 func TestToday(t *testing.T) {
 	today := date.Today()
 	now := time.Now()
@@ -76,6 +220,69 @@ func TestToday(t *testing.T) {
 	}
 }
 
+func TestNow(t *testing.T) {
+	defer func(now func() time.Time) { date.Now = now }(date.Now)
+	// July 11, 2015 at 23:30 in New York is July 12 in UTC
+	date.Now = func() time.Time {
+		return time.Date(2015, time.July, 12, 3, 30, 0, 0, time.UTC)
+	}
+	if d := date.TodayUTC(); d != date.New(2015, time.July, 12) {
+		t.Errorf("TodayUTC() == %v, want 2015-07-12", d)
+	}
+	location := time.FixedZone("zone", -4*60*60)
+	if d := date.TodayIn(location); d != date.New(2015, time.July, 11) {
+		t.Errorf("TodayIn(%v) == %v, want 2015-07-11", location, d)
+	}
+	if d := date.NewAt(date.Now().Local()); date.Today() != d {
+		t.Errorf("Today() == %v, want %v", date.Today(), d)
+	}
+
+	d := date.New(2015, time.July, 11)
+	if !d.IsTodayIn(location) || d.IsTodayUTC() || !d.IsPastUTC() || d.IsFutureUTC() {
+		t.Errorf("IsTodayIn/IsTodayUTC/IsPastUTC/IsFutureUTC(%v) == %v/%v/%v/%v, want true/false/true/false",
+			d, d.IsTodayIn(location), d.IsTodayUTC(), d.IsPastUTC(), d.IsFutureUTC())
+	}
+}
+
+func TestIsToday(t *testing.T) {
+	// Freeze the clock so the test cannot straddle midnight
+	defer func(now func() time.Time) { date.Now = now }(date.Now)
+	now := time.Now()
+	date.Now = func() time.Time { return now }
+
+	today := date.Today()
+	if !today.IsToday() || today.IsPast() || today.IsFuture() {
+		t.Errorf("IsToday/IsPast/IsFuture(%v) == %v/%v/%v, want true/false/false",
+			today, today.IsToday(), today.IsPast(), today.IsFuture())
+	}
+	if d := today.Add(-1); d.IsToday() || !d.IsPast() || d.IsFuture() {
+		t.Errorf("IsToday/IsPast/IsFuture(%v) == %v/%v/%v, want false/true/false",
+			d, d.IsToday(), d.IsPast(), d.IsFuture())
+	}
+	if d := today.Add(1); d.IsToday() || d.IsPast() || !d.IsFuture() {
+		t.Errorf("IsToday/IsPast/IsFuture(%v) == %v/%v/%v, want false/false/true",
+			d, d.IsToday(), d.IsPast(), d.IsFuture())
+	}
+
+	today = date.TodayUTC()
+	if !today.IsTodayUTC() || today.IsPastUTC() || today.IsFutureUTC() {
+		t.Errorf("IsTodayUTC/IsPastUTC/IsFutureUTC(%v) == %v/%v/%v, want true/false/false",
+			today, today.IsTodayUTC(), today.IsPastUTC(), today.IsFutureUTC())
+	}
+	if d := today.Add(-1); !d.IsPastUTC() || d.IsFutureUTC() {
+		t.Errorf("IsPastUTC/IsFutureUTC(%v) == %v/%v, want true/false", d, d.IsPastUTC(), d.IsFutureUTC())
+	}
+	if d := today.Add(1); d.IsPastUTC() || !d.IsFutureUTC() {
+		t.Errorf("IsPastUTC/IsFutureUTC(%v) == %v/%v, want false/true", d, d.IsPastUTC(), d.IsFutureUTC())
+	}
+
+	location := time.FixedZone("zone", 14*60*60)
+	today = date.TodayIn(location)
+	if !today.IsTodayIn(location) || today.Add(1).IsTodayIn(location) {
+		t.Errorf("IsTodayIn(%v) == %v, want true", today, today.IsTodayIn(location))
+	}
+}
+
 func TestTime(t *testing.T) {
 	cases := []struct {
 		year  int
@@ -121,6 +328,363 @@ func TestTime(t *testing.T) {
 	}
 }
 
+func TestMonthBounds(t *testing.T) {
+	cases := []struct {
+		value       date.Date
+		first, last date.Date
+	}{
+		{date.New(2015, time.January, 15), date.New(2015, time.January, 1), date.New(2015, time.January, 31)},
+		{date.New(2015, time.February, 1), date.New(2015, time.February, 1), date.New(2015, time.February, 28)},
+		{date.New(2016, time.February, 29), date.New(2016, time.February, 1), date.New(2016, time.February, 29)},
+		{date.New(1900, time.February, 10), date.New(1900, time.February, 1), date.New(1900, time.February, 28)},
+		{date.New(2000, time.February, 10), date.New(2000, time.February, 1), date.New(2000, time.February, 29)},
+		{date.New(2015, time.April, 30), date.New(2015, time.April, 1), date.New(2015, time.April, 30)},
+		{date.New(2015, time.December, 31), date.New(2015, time.December, 1), date.New(2015, time.December, 31)},
+		{date.New(0, time.February, 3), date.New(0, time.February, 1), date.New(0, time.February, 29)},
+		{date.New(-1, time.February, 3), date.New(-1, time.February, 1), date.New(-1, time.February, 28)},
+		{date.New(-4, time.February, 3), date.New(-4, time.February, 1), date.New(-4, time.February, 29)},
+		{date.New(1111111, time.June, 21), date.New(1111111, time.June, 1), date.New(1111111, time.June, 30)},
+	}
+	for _, c := range cases {
+		if d := c.value.FirstDayOfMonth(); d != c.first {
+			t.Errorf("FirstDayOfMonth(%v) == %v, want %v", c.value, d, c.first)
+		}
+		if d := c.value.LastDayOfMonth(); d != c.last {
+			t.Errorf("LastDayOfMonth(%v) == %v, want %v", c.value, d, c.last)
+		}
+	}
+}
+
+func TestDaysIn(t *testing.T) {
+	cases := []struct {
+		year  int
+		month time.Month
+		want  int
+	}{
+		{2015, time.January, 31},
+		{2015, time.February, 28},
+		{2016, time.February, 29},
+		{1900, time.February, 28},
+		{2000, time.February, 29},
+		{0, time.February, 29},
+		{-1, time.February, 28},
+		{2015, time.April, 30},
+		{2015, time.June, 30},
+		{2015, time.July, 31},
+		{2015, time.September, 30},
+		{2015, time.November, 30},
+		{2015, time.December, 31},
+		{2015, 14, 29},
+		{2015, 0, 31},
+	}
+	for _, c := range cases {
+		if n := date.DaysIn(c.year, c.month); n != c.want {
+			t.Errorf("DaysIn(%v, %v) == %v, want %v", c.year, c.month, n, c.want)
+		}
+		if c.month < time.January || c.month > time.December {
+			continue
+		}
+		d := date.New(c.year, c.month, 10)
+		if n := d.DaysInMonth(); n != c.want {
+			t.Errorf("DaysInMonth(%v) == %v, want %v", d, n, c.want)
+		}
+	}
+}
+
+func TestIsLeapYear(t *testing.T) {
+	cases := []struct {
+		year int
+		want bool
+	}{
+		{-401, false},
+		{-400, true},
+		{-100, false},
+		{-5, false},
+		{-4, true},
+		{-1, false},
+		{0, true},
+		{1, false},
+		{4, true},
+		{100, false},
+		{400, true},
+		{1700, false},
+		{1800, false},
+		{1900, false},
+		{1996, true},
+		{2000, true},
+		{2015, false},
+		{2016, true},
+		{2100, false},
+		{2400, true},
+	}
+	for _, c := range cases {
+		if p := date.IsLeapYear(c.year); p != c.want {
+			t.Errorf("IsLeapYear(%v) == %v, want %v", c.year, p, c.want)
+		}
+		d := date.New(c.year, time.June, 1)
+		if p := d.IsLeapYear(); p != c.want {
+			t.Errorf("IsLeapYear(%v) == %v, want %v", d, p, c.want)
+		}
+		if p := date.DaysIn(c.year, time.February) == 29; p != c.want {
+			t.Errorf("DaysIn(%v, February) == 29 is %v, want %v", c.year, p, c.want)
+		}
+	}
+}
+
+func TestQuarter(t *testing.T) {
+	cases := []struct {
+		value       date.Date
+		quarter     int
+		first, last date.Date
+	}{
+		{date.New(2015, time.January, 1), 1, date.New(2015, time.January, 1), date.New(2015, time.March, 31)},
+		{date.New(2016, time.February, 29), 1, date.New(2016, time.January, 1), date.New(2016, time.March, 31)},
+		{date.New(2015, time.March, 31), 1, date.New(2015, time.January, 1), date.New(2015, time.March, 31)},
+		{date.New(2015, time.April, 1), 2, date.New(2015, time.April, 1), date.New(2015, time.June, 30)},
+		{date.New(2015, time.June, 30), 2, date.New(2015, time.April, 1), date.New(2015, time.June, 30)},
+		{date.New(2015, time.July, 1), 3, date.New(2015, time.July, 1), date.New(2015, time.September, 30)},
+		{date.New(2015, time.September, 30), 3, date.New(2015, time.July, 1), date.New(2015, time.September, 30)},
+		{date.New(2015, time.October, 1), 4, date.New(2015, time.October, 1), date.New(2015, time.December, 31)},
+		{date.New(2015, time.December, 31), 4, date.New(2015, time.October, 1), date.New(2015, time.December, 31)},
+		{date.New(-1, time.November, 5), 4, date.New(-1, time.October, 1), date.New(-1, time.December, 31)},
+	}
+	for _, c := range cases {
+		if q := c.value.Quarter(); q != c.quarter {
+			t.Errorf("Quarter(%v) == %v, want %v", c.value, q, c.quarter)
+		}
+		if d := c.value.FirstDayOfQuarter(); d != c.first {
+			t.Errorf("FirstDayOfQuarter(%v) == %v, want %v", c.value, d, c.first)
+		}
+		if d := c.value.LastDayOfQuarter(); d != c.last {
+			t.Errorf("LastDayOfQuarter(%v) == %v, want %v", c.value, d, c.last)
+		}
+	}
+}
+
+func TestFiscalQuarter(t *testing.T) {
+	cases := []struct {
+		value date.Date
+		start time.Month
+		fy, q int
+	}{
+		{date.New(2015, time.April, 1), time.April, 2015, 1},
+		{date.New(2015, time.June, 30), time.April, 2015, 1},
+		{date.New(2015, time.July, 11), time.April, 2015, 2},
+		{date.New(2015, time.December, 31), time.April, 2015, 3},
+		{date.New(2016, time.January, 15), time.April, 2015, 4},
+		{date.New(2016, time.March, 31), time.April, 2015, 4},
+		{date.New(2015, time.October, 1), time.October, 2015, 1},
+		{date.New(2015, time.September, 30), time.October, 2014, 4},
+		{date.New(2015, time.February, 1), time.February, 2015, 1},
+		{date.New(2015, time.January, 31), time.February, 2014, 4},
+		{date.New(0, time.March, 1), time.July, -1, 3},
+	}
+	for _, c := range cases {
+		if fy, q := c.value.FiscalQuarter(c.start); fy != c.fy || q != c.q {
+			t.Errorf("FiscalQuarter(%v, %v) == (%v, %v), want (%v, %v)", c.value, c.start, fy, q, c.fy, c.q)
+		}
+	}
+
+	// Fiscal years starting in January match calendar years
+	for d := date.New(2015, time.January, 1); d.Year() < 2017; d = d.Add(1) {
+		if fy, q := d.FiscalQuarter(time.January); fy != d.Year() || q != d.Quarter() {
+			t.Errorf("FiscalQuarter(%v, January) == (%v, %v), want (%v, %v)", d, fy, q, d.Year(), d.Quarter())
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("FiscalQuarter with month 13 did not panic")
+		}
+	}()
+	date.New(2015, time.July, 11).FiscalQuarter(13)
+}
+
+func TestInZoneTransitions(t *testing.T) {
+	cases := []struct {
+		zone  string
+		value date.Date
+		want  string
+	}{
+		// Daylight saving time started at midnight
+		{"America/Sao_Paulo", date.New(2018, time.November, 4), "2018-11-04T01:00:00-02"},
+		{"America/Sao_Paulo", date.New(2019, time.February, 17), "2019-02-17T00:00:00-03"},
+		// Daylight saving time started after midnight but before midnight UTC
+		{"Australia/Sydney", date.New(2015, time.October, 4), "2015-10-04T00:00:00+10"},
+		{"Australia/Sydney", date.New(2016, time.April, 3), "2016-04-03T00:00:00+11"},
+		// Midnight occurred twice
+		{"Asia/Tehran", date.New(1978, time.August, 5), "1978-08-05T00:00:00+05"},
+		// Large local mean time offsets and skipped days
+		{"Asia/Manila", date.New(1844, time.December, 30), "1844-12-30T00:00:00-15"},
+		{"Asia/Manila", date.New(1844, time.December, 31), "1845-01-01T00:00:00+08"},
+		{"Asia/Manila", date.New(1845, time.January, 1), "1845-01-01T00:00:00+08"},
+		{"Pacific/Apia", date.New(2011, time.December, 30), "2011-12-31T00:00:00+14"},
+	}
+	for _, c := range cases {
+		loc, err := time.LoadLocation(c.zone)
+		if err != nil {
+			t.Errorf("LoadLocation(%v) error %v", c.zone, err)
+			continue
+		}
+		// Check whole hours of the offset only, as local mean time
+		// offsets vary with the version of the time zone database
+		if s := c.value.In(loc).Format("2006-01-02T15:04:05-07"); s != c.want {
+			t.Errorf("In(%v, %v) == %v, want %v", c.value, c.zone, s, c.want)
+		}
+	}
+
+	// The result must be the first instant of the day in each zone
+	zones := []string{"America/Sao_Paulo", "America/Havana", "Asia/Beirut", "Asia/Tehran", "Australia/Sydney", "Europe/London", "Pacific/Apia"}
+	for _, z := range zones {
+		loc, err := time.LoadLocation(z)
+		if err != nil {
+			t.Errorf("LoadLocation(%v) error %v", z, err)
+			continue
+		}
+		for d := date.New(1970, time.January, 1); d.Year() < 2025; d = d.Add(1) {
+			tIn := d.In(loc)
+			if tIn.Location() != loc {
+				t.Errorf("In(%v, %v) == %v, want %v", d, z, tIn.Location(), loc)
+			}
+			if u := date.NewAt(tIn); u != d && !(u == d.Add(1) && tIn.Hour() == 0) {
+				t.Errorf("In(%v, %v) == %v, want date part %v", d, z, tIn, d)
+			}
+			if u := date.NewAt(tIn.Add(-time.Nanosecond)); !u.Before(d) {
+				t.Errorf("In(%v, %v) == %v, not the start of the day", d, z, tIn)
+			}
+		}
+	}
+}
+
+func TestDayBounds(t *testing.T) {
+	sydney, err := time.LoadLocation("Australia/Sydney")
+	if err != nil {
+		t.Fatalf("LoadLocation(Australia/Sydney) error %v", err)
+	}
+	cases := []struct {
+		value date.Date
+		loc   *time.Location
+		hours time.Duration
+	}{
+		{date.New(2015, time.July, 11), time.UTC, 24},
+		{date.New(2015, time.July, 11), time.FixedZone("zone", -5*60*60), 24},
+		{date.New(2015, time.October, 4), sydney, 23},
+		{date.New(2016, time.April, 3), sydney, 25},
+	}
+	for _, c := range cases {
+		start, end := c.value.DayBounds(c.loc)
+		if start != c.value.StartOfDay(c.loc) || !start.Equal(c.value.In(c.loc)) {
+			t.Errorf("DayBounds(%v, %v) start == %v, want %v", c.value, c.loc, start, c.value.In(c.loc))
+		}
+		if !end.Equal(c.value.Add(1).In(c.loc)) {
+			t.Errorf("DayBounds(%v, %v) end == %v, want %v", c.value, c.loc, end, c.value.Add(1).In(c.loc))
+		}
+		if d := end.Sub(start); d != c.hours*time.Hour {
+			t.Errorf("DayBounds(%v, %v) lasts %v, want %v", c.value, c.loc, d, c.hours*time.Hour)
+		}
+		last := c.value.EndOfDay(c.loc)
+		if !last.Equal(end.Add(-time.Nanosecond)) || date.NewAt(last) != c.value || last.Location() != c.loc {
+			t.Errorf("EndOfDay(%v, %v) == %v", c.value, c.loc, last)
+		}
+		if last.Hour() != 23 || last.Minute() != 59 || last.Second() != 59 || last.Nanosecond() != 999999999 {
+			t.Errorf("EndOfDay(%v, %v) == %v, want 23:59:59.999999999", c.value, c.loc, last)
+		}
+	}
+}
+
+func TestAt(t *testing.T) {
+	sydney, err := time.LoadLocation("Australia/Sydney")
+	if err != nil {
+		t.Fatalf("LoadLocation(Australia/Sydney) error %v", err)
+	}
+	cases := []struct {
+		value                date.Date
+		hour, min, sec, nsec int
+		loc                  *time.Location
+		want                 time.Time
+	}{
+		{date.New(2015, time.July, 11), 0, 0, 0, 0, time.UTC, time.Date(2015, time.July, 11, 0, 0, 0, 0, time.UTC)},
+		{date.New(2015, time.July, 11), 13, 45, 30, 500, time.UTC, time.Date(2015, time.July, 11, 13, 45, 30, 500, time.UTC)},
+		{date.New(2015, time.July, 11), 23, 59, 59, 999999999, sydney, time.Date(2015, time.July, 11, 23, 59, 59, 999999999, sydney)},
+		{date.New(2015, time.July, 11), 24, 0, 0, 0, time.UTC, time.Date(2015, time.July, 12, 0, 0, 0, 0, time.UTC)},
+		{date.New(-1, time.December, 31), 12, 0, 0, 0, time.UTC, time.Date(-1, time.December, 31, 12, 0, 0, 0, time.UTC)},
+		// 02:30 does not exist in Sydney when daylight saving time starts
+		{date.New(2015, time.October, 4), 2, 30, 0, 0, sydney, time.Date(2015, time.October, 4, 2, 30, 0, 0, sydney)},
+	}
+	for _, c := range cases {
+		tm := c.value.At(c.hour, c.min, c.sec, c.nsec, c.loc)
+		if !tm.Equal(c.want) || tm.Location() != c.loc {
+			t.Errorf("At(%v, %v, %v, %v, %v, %v) == %v, want %v", c.value, c.hour, c.min, c.sec, c.nsec, c.loc, tm, c.want)
+		}
+	}
+
+	// The date part must survive a round trip through At and NewAt
+	for _, d := range []date.Date{date.New(-1234, time.February, 5), date.New(1970, time.January, 1), date.New(2015, time.July, 11), date.New(1111111, time.June, 21)} {
+		for _, loc := range []*time.Location{time.UTC, sydney} {
+			if u := date.NewAt(d.At(9, 30, 0, 0, loc)); u != d {
+				t.Errorf("NewAt(At(%v, 9:30, %v)) == %v, want %v", d, loc, u, d)
+			}
+		}
+	}
+}
+
+func TestToFromTimes(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+	dates := []date.Date{
+		date.New(-1234, time.February, 5),
+		date.New(1970, time.January, 1),
+		date.New(2015, time.July, 11),
+		date.New(2015, time.July, 11),
+		date.New(1111111, time.June, 21),
+	}
+	for _, loc := range []*time.Location{time.UTC, tokyo} {
+		times := date.ToTimes(dates, loc)
+		if len(times) != len(dates) {
+			t.Fatalf("ToTimes(%v, %v) == %v, want %v times", dates, loc, times, len(dates))
+		}
+		for i, tm := range times {
+			if !tm.Equal(dates[i].In(loc)) || tm.Location() != loc {
+				t.Errorf("ToTimes(%v, %v)[%d] == %v, want %v", dates, loc, i, tm, dates[i].In(loc))
+			}
+		}
+		if ds := date.FromTimes(times); !sameDates(ds, dates) {
+			t.Errorf("FromTimes(ToTimes(%v, %v)) == %v, want %v", dates, loc, ds, dates)
+		}
+	}
+
+	// The civil date is taken in the location of each time
+	times := []time.Time{
+		time.Date(2015, time.July, 11, 23, 0, 0, 0, time.UTC),
+		time.Date(2015, time.July, 11, 23, 0, 0, 0, time.UTC).In(tokyo),
+	}
+	want := []date.Date{date.New(2015, time.July, 11), date.New(2015, time.July, 12)}
+	if ds := date.FromTimes(times); !sameDates(ds, want) {
+		t.Errorf("FromTimes(%v) == %v, want %v", times, ds, want)
+	}
+
+	if ts := date.ToTimes(nil, time.UTC); len(ts) != 0 {
+		t.Errorf("ToTimes(nil) == %v, want []", ts)
+	}
+	if ds := date.FromTimes(nil); len(ds) != 0 {
+		t.Errorf("FromTimes(nil) == %v, want []", ds)
+	}
+}
+
+func BenchmarkToTimes(b *testing.B) {
+	dates := benchmarkDates()
+	for i := 0; i < b.N; i++ {
+		date.ToTimes(dates, time.UTC)
+	}
+}
+
+func BenchmarkFromTimes(b *testing.B) {
+	times := date.ToTimes(benchmarkDates(), time.UTC)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		date.FromTimes(times)
+	}
+}
+
 func TestPredicates(t *testing.T) {
 	// The list of case dates must be sorted in ascending order
 	cases := []struct {
@@ -156,6 +720,16 @@ func TestPredicates(t *testing.T) {
 			if p != q {
 				t.Errorf("After(%v, %v) == %v, want %v", di, dj, p, q)
 			}
+			n := di.Compare(dj)
+			m := 0
+			if i < j {
+				m = -1
+			} else if i > j {
+				m = +1
+			}
+			if n != m {
+				t.Errorf("Compare(%v, %v) == %v, want %v", di, dj, n, m)
+			}
 			p = di == dj
 			q = i == j
 			if p != q {
@@ -180,6 +754,141 @@ func TestPredicates(t *testing.T) {
 	}
 }
 
+func TestSame(t *testing.T) {
+	cases := []struct {
+		d, u                 date.Date
+		year, month, isoWeek bool
+	}{
+		{date.New(2015, time.July, 11), date.New(2015, time.July, 11), true, true, true},
+		{date.New(2015, time.July, 11), date.New(2015, time.July, 6), true, true, true},
+		{date.New(2015, time.July, 11), date.New(2015, time.July, 12), true, true, true},
+		{date.New(2015, time.July, 11), date.New(2015, time.July, 13), true, true, false},
+		{date.New(2015, time.July, 1), date.New(2015, time.June, 30), true, false, true},
+		{date.New(2015, time.July, 11), date.New(2016, time.July, 11), false, false, false},
+		{date.New(2015, time.July, 11), date.New(2014, time.July, 11), false, false, false},
+		// ISO weeks across the end of the year
+		{date.New(2018, time.December, 31), date.New(2019, time.January, 1), false, false, true},
+		{date.New(2020, time.December, 31), date.New(2021, time.January, 1), false, false, true},
+		{date.New(2021, time.January, 1), date.New(2021, time.January, 4), true, true, false},
+		{date.New(2021, time.January, 3), date.New(2020, time.December, 28), false, false, true},
+		{date.New(2015, time.December, 28), date.New(2014, time.December, 29), false, false, false},
+	}
+	for _, c := range cases {
+		for _, p := range [][2]date.Date{{c.d, c.u}, {c.u, c.d}} {
+			if b := p[0].SameYear(p[1]); b != c.year {
+				t.Errorf("SameYear(%v, %v) == %v, want %v", p[0], p[1], b, c.year)
+			}
+			if b := p[0].SameMonth(p[1]); b != c.month {
+				t.Errorf("SameMonth(%v, %v) == %v, want %v", p[0], p[1], b, c.month)
+			}
+			if b := p[0].SameISOWeek(p[1]); b != c.isoWeek {
+				t.Errorf("SameISOWeek(%v, %v) == %v, want %v", p[0], p[1], b, c.isoWeek)
+			}
+		}
+	}
+}
+
+func TestEarliestLatest(t *testing.T) {
+	d1 := date.New(-1234, time.February, 5)
+	d2 := date.New(1970, time.January, 1)
+	d3 := date.New(2015, time.July, 11)
+	cases := []struct {
+		a, b             date.Date
+		earliest, latest date.Date
+	}{
+		{d1, d2, d1, d2},
+		{d2, d1, d1, d2},
+		{d3, d3, d3, d3},
+	}
+	for _, c := range cases {
+		if d := date.Earliest(c.a, c.b); d != c.earliest {
+			t.Errorf("Earliest(%v, %v) == %v, want %v", c.a, c.b, d, c.earliest)
+		}
+		if d := date.Latest(c.a, c.b); d != c.latest {
+			t.Errorf("Latest(%v, %v) == %v, want %v", c.a, c.b, d, c.latest)
+		}
+	}
+
+	if d := date.MinOf(d2, d3, d1, d2); d != d1 {
+		t.Errorf("MinOf(%v, %v, %v, %v) == %v, want %v", d2, d3, d1, d2, d, d1)
+	}
+	if d := date.MaxOf(d2, d3, d1, d2); d != d3 {
+		t.Errorf("MaxOf(%v, %v, %v, %v) == %v, want %v", d2, d3, d1, d2, d, d3)
+	}
+	if d := date.MinOf(d2); d != d2 {
+		t.Errorf("MinOf(%v) == %v, want %v", d2, d, d2)
+	}
+
+	for _, f := range []func(...date.Date) date.Date{date.MinOf, date.MaxOf} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("MinOf/MaxOf() did not panic")
+				}
+			}()
+			f()
+		}()
+	}
+}
+
+func TestBetween(t *testing.T) {
+	start := date.New(2015, time.July, 1)
+	end := date.New(2015, time.July, 31)
+	cases := []struct {
+		value     date.Date
+		inclusive bool
+		exclusive bool
+	}{
+		{start.Add(-1), false, false},
+		{start, true, true},
+		{start.Add(1), true, true},
+		{end.Add(-1), true, true},
+		{end, true, false},
+		{end.Add(1), false, false},
+	}
+	for _, c := range cases {
+		if p := c.value.Between(start, end); p != c.inclusive {
+			t.Errorf("Between(%v, %v, %v) == %v, want %v", c.value, start, end, p, c.inclusive)
+		}
+		if p := c.value.Between(end, start); p != c.inclusive {
+			t.Errorf("Between(%v, %v, %v) == %v, want %v", c.value, end, start, p, c.inclusive)
+		}
+		if p := c.value.BetweenExclusive(start, end); p != c.exclusive {
+			t.Errorf("BetweenExclusive(%v, %v, %v) == %v, want %v", c.value, start, end, p, c.exclusive)
+		}
+		if p := c.value.BetweenExclusive(end, start); p != c.exclusive {
+			t.Errorf("BetweenExclusive(%v, %v, %v) == %v, want %v", c.value, end, start, p, c.exclusive)
+		}
+	}
+}
+
+func TestClamp(t *testing.T) {
+	min := date.New(2015, time.July, 1)
+	max := date.New(2015, time.July, 31)
+	cases := []struct {
+		value, min, max date.Date
+		want            date.Date
+	}{
+		{date.Min(), min, max, min},
+		{min.Add(-1), min, max, min},
+		{min, min, max, min},
+		{min.Add(1), min, max, min.Add(1)},
+		{max.Add(-1), min, max, max.Add(-1)},
+		{max, min, max, max},
+		{max.Add(1), min, max, max},
+		{date.Max(), min, max, max},
+		{min, min, min, min},
+		{min.Add(5), max, min, max},
+		{max.Add(5), max, min, max},
+	}
+	for _, c := range cases {
+		d := c.value.Clamp(c.min, c.max)
+		if d != c.want {
+			t.Errorf("Clamp(%v, %v, %v) == %v, want %v", c.value, c.min, c.max, d, c.want)
+		}
+	}
+}
+
 func TestArithmetic(t *testing.T) {
 	cases := []struct {
 		year  int
@@ -212,6 +921,296 @@ func TestArithmetic(t *testing.T) {
 	}
 }
 
+func TestAddWeeks(t *testing.T) {
+	cases := []struct {
+		value date.Date
+		weeks int
+		want  date.Date
+	}{
+		{date.New(2015, time.July, 11), 0, date.New(2015, time.July, 11)},
+		{date.New(2015, time.July, 11), 1, date.New(2015, time.July, 18)},
+		{date.New(2015, time.July, 11), 3, date.New(2015, time.August, 1)},
+		{date.New(2015, time.December, 28), 1, date.New(2016, time.January, 4)},
+		{date.New(2015, time.July, 11), -1, date.New(2015, time.July, 4)},
+		{date.New(2016, time.March, 1), -1, date.New(2016, time.February, 23)},
+		{date.New(1970, time.January, 1), -52, date.New(1969, time.January, 2)},
+	}
+	for _, c := range cases {
+		if d := c.value.AddWeeks(c.weeks); d != c.want {
+			t.Errorf("AddWeeks(%v, %v) == %v, want %v", c.value, c.weeks, d, c.want)
+		}
+		if d := c.value.SubWeeks(-c.weeks); d != c.want {
+			t.Errorf("SubWeeks(%v, %v) == %v, want %v", c.value, -c.weeks, d, c.want)
+		}
+	}
+}
+
+func TestAddYears(t *testing.T) {
+	cases := []struct {
+		value date.Date
+		years int
+		want  date.Date
+	}{
+		{date.New(2015, time.July, 11), 0, date.New(2015, time.July, 11)},
+		{date.New(2015, time.July, 11), 1, date.New(2016, time.July, 11)},
+		{date.New(2015, time.July, 11), -2015, date.New(0, time.July, 11)},
+		{date.New(2016, time.February, 29), 4, date.New(2020, time.February, 29)},
+		{date.New(2016, time.February, 29), 1, date.New(2017, time.March, 1)},
+		{date.New(2016, time.February, 29), -1, date.New(2015, time.March, 1)},
+	}
+	for _, c := range cases {
+		if d := c.value.AddYears(c.years); d != c.want {
+			t.Errorf("AddYears(%v, %v) == %v, want %v", c.value, c.years, d, c.want)
+		}
+	}
+}
+
+func TestAddMonths(t *testing.T) {
+	cases := []struct {
+		value           date.Date
+		months          int
+		overflow, clamp date.Date
+	}{
+		{date.New(2015, time.January, 15), 1, date.New(2015, time.February, 15), date.New(2015, time.February, 15)},
+		{date.New(2015, time.January, 31), 1, date.New(2015, time.March, 3), date.New(2015, time.February, 28)},
+		{date.New(2016, time.January, 31), 1, date.New(2016, time.March, 2), date.New(2016, time.February, 29)},
+		{date.New(2015, time.March, 31), -1, date.New(2015, time.March, 3), date.New(2015, time.February, 28)},
+		{date.New(2015, time.March, 31), 1, date.New(2015, time.May, 1), date.New(2015, time.April, 30)},
+		{date.New(2015, time.August, 31), 6, date.New(2016, time.March, 2), date.New(2016, time.February, 29)},
+		{date.New(2015, time.December, 31), 2, date.New(2016, time.March, 2), date.New(2016, time.February, 29)},
+		{date.New(2016, time.February, 29), 12, date.New(2017, time.March, 1), date.New(2017, time.February, 28)},
+		{date.New(2016, time.February, 29), -48, date.New(2012, time.February, 29), date.New(2012, time.February, 29)},
+		{date.New(2015, time.July, 11), 0, date.New(2015, time.July, 11), date.New(2015, time.July, 11)},
+	}
+	for _, c := range cases {
+		if d := c.value.AddMonths(c.months, false); d != c.overflow {
+			t.Errorf("AddMonths(%v, %v, false) == %v, want %v", c.value, c.months, d, c.overflow)
+		}
+		if d := c.value.AddMonths(c.months, true); d != c.clamp {
+			t.Errorf("AddMonths(%v, %v, true) == %v, want %v", c.value, c.months, d, c.clamp)
+		}
+	}
+}
+
+func TestPlusMonthsSQL(t *testing.T) {
+	cases := []struct {
+		value  date.Date
+		months int
+		want   date.Date
+	}{
+		{date.New(2015, time.January, 31), 1, date.New(2015, time.February, 28)},
+		{date.New(2016, time.January, 31), 1, date.New(2016, time.February, 29)},
+		{date.New(2015, time.January, 31), 2, date.New(2015, time.March, 31)},
+		{date.New(2015, time.January, 31), 3, date.New(2015, time.April, 30)},
+		{date.New(2015, time.May, 31), -1, date.New(2015, time.April, 30)},
+		{date.New(2015, time.March, 30), -1, date.New(2015, time.February, 28)},
+		{date.New(2015, time.December, 31), 14, date.New(2017, time.February, 28)},
+		{date.New(2016, time.February, 29), 12, date.New(2017, time.February, 28)},
+		{date.New(2015, time.July, 11), 0, date.New(2015, time.July, 11)},
+	}
+	for _, c := range cases {
+		if d := c.value.PlusMonthsSQL(c.months); d != c.want {
+			t.Errorf("PlusMonthsSQL(%v, %v) == %v, want %v", c.value, c.months, d, c.want)
+		}
+	}
+}
+
+func TestWith(t *testing.T) {
+	cases := []struct {
+		value date.Date
+		with  func(date.Date) date.Date
+		name  string
+		want  date.Date
+	}{
+		{date.New(2015, time.July, 11), func(d date.Date) date.Date { return d.WithYear(2000) }, "WithYear(2000)", date.New(2000, time.July, 11)},
+		{date.New(2016, time.February, 29), func(d date.Date) date.Date { return d.WithYear(2020) }, "WithYear(2020)", date.New(2020, time.February, 29)},
+		{date.New(2016, time.February, 29), func(d date.Date) date.Date { return d.WithYear(2015) }, "WithYear(2015)", date.New(2015, time.March, 1)},
+		{date.New(2016, time.February, 29), func(d date.Date) date.Date { return d.WithYear(-1) }, "WithYear(-1)", date.New(-1, time.March, 1)},
+		{date.New(2015, time.July, 11), func(d date.Date) date.Date { return d.WithMonth(time.March) }, "WithMonth(March)", date.New(2015, time.March, 11)},
+		{date.New(2015, time.May, 31), func(d date.Date) date.Date { return d.WithMonth(time.June) }, "WithMonth(June)", date.New(2015, time.July, 1)},
+		{date.New(2015, time.July, 11), func(d date.Date) date.Date { return d.WithMonth(13) }, "WithMonth(13)", date.New(2016, time.January, 11)},
+		{date.New(2015, time.July, 11), func(d date.Date) date.Date { return d.WithDay(1) }, "WithDay(1)", date.New(2015, time.July, 1)},
+		{date.New(2015, time.February, 11), func(d date.Date) date.Date { return d.WithDay(31) }, "WithDay(31)", date.New(2015, time.March, 3)},
+		{date.New(2016, time.February, 11), func(d date.Date) date.Date { return d.WithDay(31) }, "WithDay(31)", date.New(2016, time.March, 2)},
+		{date.New(2015, time.July, 11), func(d date.Date) date.Date { return d.WithDay(0) }, "WithDay(0)", date.New(2015, time.June, 30)},
+	}
+	for _, c := range cases {
+		if d := c.with(c.value); d != c.want {
+			t.Errorf("%s(%v) == %v, want %v", c.name, c.value, d, c.want)
+		}
+	}
+}
+
+func TestDaysUntilSince(t *testing.T) {
+	today := date.New(2015, time.July, 11)
+	cases := []struct {
+		value date.Date
+		until int
+	}{
+		{date.New(2015, time.July, 11), 0},
+		{date.New(2015, time.July, 12), 1},
+		{date.New(2015, time.August, 1), 21},
+		{date.New(2016, time.July, 11), 366},
+		{date.New(2015, time.July, 10), -1},
+		{date.New(1970, time.January, 1), -16627},
+	}
+	for _, c := range cases {
+		if n := today.DaysUntil(c.value); n != c.until {
+			t.Errorf("DaysUntil(%v, %v) == %v, want %v", today, c.value, n, c.until)
+		}
+		if n := c.value.DaysSince(today); n != c.until {
+			t.Errorf("DaysSince(%v, %v) == %v, want %v", c.value, today, n, c.until)
+		}
+		if n := today.DaysSince(c.value); n != -c.until {
+			t.Errorf("DaysSince(%v, %v) == %v, want %v", today, c.value, n, -c.until)
+		}
+	}
+}
+
+func TestDiffInMonths(t *testing.T) {
+	cases := []struct {
+		d, u          date.Date
+		months, years int
+	}{
+		{date.New(2020, time.January, 15), date.New(2020, time.January, 15), 0, 0},
+		{date.New(2020, time.February, 14), date.New(2020, time.January, 15), 0, 0},
+		{date.New(2020, time.February, 15), date.New(2020, time.January, 15), 1, 0},
+		{date.New(2020, time.February, 29), date.New(2020, time.January, 31), 0, 0},
+		{date.New(2020, time.March, 1), date.New(2020, time.January, 31), 1, 0},
+		{date.New(2020, time.March, 31), date.New(2020, time.January, 31), 2, 0},
+		{date.New(2020, time.April, 30), date.New(2020, time.March, 31), 0, 0},
+		{date.New(2021, time.January, 14), date.New(2020, time.January, 15), 11, 0},
+		{date.New(2021, time.January, 15), date.New(2020, time.January, 15), 12, 1},
+		{date.New(2021, time.February, 28), date.New(2020, time.February, 29), 11, 0},
+		{date.New(2021, time.March, 1), date.New(2020, time.February, 29), 12, 1},
+		{date.New(2015, time.July, 11), date.New(1970, time.January, 1), 546, 45},
+		{date.New(2020, time.January, 15), date.New(2020, time.February, 14), 0, 0},
+		{date.New(2020, time.January, 15), date.New(2020, time.February, 15), -1, 0},
+		{date.New(2020, time.January, 15), date.New(2021, time.January, 15), -12, -1},
+		{date.New(-1, time.December, 31), date.New(1, time.January, 1), -12, -1},
+	}
+	for _, c := range cases {
+		if n := c.d.DiffInMonths(c.u); n != c.months {
+			t.Errorf("DiffInMonths(%v, %v) == %v, want %v", c.d, c.u, n, c.months)
+		}
+		if n := c.d.DiffInYears(c.u); n != c.years {
+			t.Errorf("DiffInYears(%v, %v) == %v, want %v", c.d, c.u, n, c.years)
+		}
+		if !c.d.Before(c.u) && c.u.Age(c.d) != c.years {
+			t.Errorf("Age(%v, %v) == %v, want %v", c.u, c.d, c.u.Age(c.d), c.years)
+		}
+	}
+}
+
+func TestAge(t *testing.T) {
+	cases := []struct {
+		birth, asOf date.Date
+		want        int
+	}{
+		{date.New(2000, time.June, 1), date.New(2000, time.June, 1), 0},
+		{date.New(2000, time.June, 1), date.New(2001, time.May, 31), 0},
+		{date.New(2000, time.June, 1), date.New(2001, time.June, 1), 1},
+		{date.New(2000, time.June, 1), date.New(2015, time.July, 11), 15},
+		{date.New(2000, time.February, 29), date.New(2001, time.February, 28), 0},
+		{date.New(2000, time.February, 29), date.New(2001, time.March, 1), 1},
+		{date.New(2000, time.February, 29), date.New(2004, time.February, 28), 3},
+		{date.New(2000, time.February, 29), date.New(2004, time.February, 29), 4},
+		{date.New(2000, time.February, 28), date.New(2001, time.February, 28), 1},
+		{date.New(2000, time.March, 1), date.New(2001, time.February, 28), 0},
+		{date.New(2000, time.March, 1), date.New(2001, time.March, 1), 1},
+		{date.New(-1, time.December, 31), date.New(1, time.January, 1), 1},
+		{date.New(2000, time.June, 1), date.New(1999, time.July, 1), 0},
+		{date.New(2000, time.June, 1), date.New(1999, time.June, 1), -1},
+		{date.New(2000, time.June, 1), date.New(1990, time.January, 1), -10},
+	}
+	for _, c := range cases {
+		if n := c.birth.Age(c.asOf); n != c.want {
+			t.Errorf("Age(%v, %v) == %v, want %v", c.birth, c.asOf, n, c.want)
+		}
+	}
+}
+
+func TestCheckedArithmetic(t *testing.T) {
+	d := date.New(2015, time.July, 11)
+	addCases := []struct {
+		value date.Date
+		days  date.PeriodOfDays
+		want  date.Date
+		ok    bool
+	}{
+		{d, 0, d, true},
+		{d, 10, d.Add(10), true},
+		{d, -10, d.Add(-10), true},
+		{date.Max(), 0, date.Max(), true},
+		{date.Max().Add(-1), 1, date.Max(), true},
+		{date.Max(), 1, date.Min(), false},
+		{date.Min(), -1, date.Max(), false},
+		{date.Min().Add(1), -1, date.Min(), true},
+		{date.Min(), math.MaxInt32, date.Date{}.Add(-1), true},
+		{d, math.MaxInt32, d.Add(math.MaxInt32), false},
+	}
+	for _, c := range addCases {
+		u, ok := c.value.AddChecked(c.days)
+		if ok != c.ok || (ok && u != c.want) {
+			t.Errorf("AddChecked(%v, %v) == %v, %v, want %v, %v", c.value, c.days, u, ok, c.want, c.ok)
+		}
+	}
+
+	subCases := []struct {
+		d, u date.Date
+		want date.PeriodOfDays
+		ok   bool
+	}{
+		{d, d, 0, true},
+		{d.Add(10), d, 10, true},
+		{d, d.Add(10), -10, true},
+		{date.Max(), date.Date{}, math.MaxInt32, true},
+		{date.Min(), date.Date{}, math.MinInt32, true},
+		{date.Max(), date.Date{}.Add(-1), 0, false},
+		{date.Max(), date.Min(), 0, false},
+		{date.Min(), date.Max(), 0, false},
+	}
+	for _, c := range subCases {
+		n, ok := c.d.SubChecked(c.u)
+		if ok != c.ok || (ok && n != c.want) {
+			t.Errorf("SubChecked(%v, %v) == %v, %v, want %v, %v", c.d, c.u, n, ok, c.want, c.ok)
+		}
+	}
+
+	addDateCases := []struct {
+		value               date.Date
+		years, months, days int
+		want                date.Date
+		ok                  bool
+	}{
+		{d, 0, 0, 0, d, true},
+		{d, 1, 2, 3, date.New(2016, time.September, 14), true},
+		{d, 1000000, 0, 0, date.New(1002015, time.July, 11), true},
+		{d, -1000000, 0, 0, date.New(-997985, time.July, 11), true},
+		{d, 5879565, 0, 0, date.New(5881580, time.July, 11), true},
+		{d, 5879565, 0, 1, date.Date{}, false},
+		{d, 5879566, 0, 0, date.Date{}, false},
+		{d, 0, 12 * 5879565, 0, date.Max(), true},
+		{d, 0, 12*5879565 + 1, 0, date.Date{}, false},
+		{d, -5879656, 0, 0, date.New(-5877641, time.July, 11), true},
+		{date.Min(), 0, 0, -1, date.Date{}, false},
+		{date.Min(), 0, -1, 0, date.Date{}, false},
+		{date.Min(), 0, 1, 0, date.New(-5877641, time.July, 23), true},
+		{date.Max(), -1, 0, 0, date.New(5881579, time.July, 11), true},
+		{date.Max(), 10000000, 0, 0, date.Date{}, false},
+		{date.Min(), -10000000, 0, 0, date.Date{}, false},
+	}
+	for _, c := range addDateCases {
+		u, ok := c.value.AddDateChecked(c.years, c.months, c.days)
+		if ok != c.ok || (ok && u != c.want) {
+			t.Errorf("AddDateChecked(%v, %v, %v, %v) == %v, %v, want %v, %v", c.value, c.years, c.months, c.days, u, ok, c.want, c.ok)
+		}
+		if ok && u != c.value.AddDate(c.years, c.months, c.days) {
+			t.Errorf("AddDateChecked(%v, %v, %v, %v) == %v, want %v", c.value, c.years, c.months, c.days, u, c.value.AddDate(c.years, c.months, c.days))
+		}
+	}
+}
+
 func TestGobEncoding(t *testing.T) {
 	var b bytes.Buffer
 	encoder := gob.NewEncoder(&b)
@@ -224,6 +1223,9 @@ func TestGobEncoding(t *testing.T) {
 		date.New(1970, time.January, 1),
 		date.New(2012, time.June, 25),
 		date.New(12345, time.June, 7),
+		date.Min(),
+		date.Max(),
+		date.Date{},
 	}
 	for _, c := range cases {
 		var d date.Date
@@ -234,6 +1236,57 @@ func TestGobEncoding(t *testing.T) {
 			err = decoder.Decode(&d)
 			if err != nil {
 				t.Errorf("Gob(%v) decode error %v", c, err)
+			} else if d != c {
+				t.Errorf("Gob(%v) == %v, want %v", c, d, c)
+			}
+		}
+	}
+
+	// Test a slice of dates
+	var ds []date.Date
+	err := encoder.Encode(cases)
+	if err != nil {
+		t.Fatalf("Gob(%v) encode error %v", cases, err)
+	}
+	err = decoder.Decode(&ds)
+	if err != nil {
+		t.Fatalf("Gob(%v) decode error %v", cases, err)
+	}
+	if len(ds) != len(cases) {
+		t.Fatalf("Gob(%v) == %v, want %v", cases, ds, cases)
+	}
+	for i, c := range cases {
+		if ds[i] != c {
+			t.Errorf("Gob(%v)[%d] == %v, want %v", cases, i, ds[i], c)
+		}
+	}
+}
+
+func TestBinaryMarshalling(t *testing.T) {
+	cases := []struct {
+		value date.Date
+		want  []byte
+	}{
+		{date.Min(), []byte{0x80, 0, 0, 0}},
+		{date.New(1969, time.December, 31), []byte{0xff, 0xff, 0xff, 0xff}},
+		{date.Date{}, []byte{0, 0, 0, 0}},
+		{date.New(1970, time.January, 2), []byte{0, 0, 0, 1}},
+		{date.New(2015, time.July, 11), []byte{0, 0, 0x40, 0xf3}},
+		{date.Max(), []byte{0x7f, 0xff, 0xff, 0xff}},
+	}
+	for _, c := range cases {
+		var d date.Date
+		data, err := c.value.MarshalBinary()
+		if err != nil {
+			t.Errorf("Binary(%v) marshal error %v", c.value, err)
+		} else if !bytes.Equal(data, c.want) {
+			t.Errorf("Binary(%v) == %v, want %v", c.value, data, c.want)
+		} else {
+			err = d.UnmarshalBinary(data)
+			if err != nil {
+				t.Errorf("Binary(%v) unmarshal error %v", c.value, err)
+			} else if d != c.value {
+				t.Errorf("Binary(%v) unmarshal == %v, want %v", c.value, d, c.value)
 			}
 		}
 	}
@@ -246,6 +1299,7 @@ func TestInvalidGob(t *testing.T) {
 	}{
 		{[]byte{}, "Date.UnmarshalBinary: no data"},
 		{[]byte{1, 2, 3}, "Date.UnmarshalBinary: invalid length"},
+		{[]byte{1, 2, 3, 4, 5}, "Date.UnmarshalBinary: invalid length"},
 	}
 	for _, c := range cases {
 		var ignored date.Date
@@ -273,6 +1327,8 @@ func TestJSONMarshalling(t *testing.T) {
 		{date.New(1970, time.January, 1), `"1970-01-01"`},
 		{date.New(2012, time.June, 25), `"2012-06-25"`},
 		{date.New(12345, time.June, 7), `"+12345-06-07"`},
+		{date.Min(), `"-5877641-06-23"`},
+		{date.Max(), `"+5881580-07-11"`},
 	}
 	for _, c := range cases {
 		bytes, err := json.Marshal(c.value)
@@ -284,9 +1340,20 @@ func TestJSONMarshalling(t *testing.T) {
 			err = json.Unmarshal(bytes, &d)
 			if err != nil {
 				t.Errorf("JSON(%v) unmarshal error %v", c.value, err)
+			} else if d != c.value {
+				t.Errorf("JSON(%v) unmarshal == %v, want %v", c.value, d, c.value)
 			}
 		}
 	}
+
+	// Test that null leaves the date untouched
+	var s struct {
+		D date.Date
+	}
+	err := json.Unmarshal([]byte(`{"D":null}`), &s)
+	if err != nil || !s.D.IsZero() {
+		t.Errorf("JSON(null) == %v (%v), want zero date", s.D, err)
+	}
 }
 
 func TestInvalidJSON(t *testing.T) {
@@ -344,6 +1411,7 @@ func TestInvalidText(t *testing.T) {
 	}{
 		{`not-a-date`, `Date.ParseISO: cannot parse not-a-date`},
 		{`215-08-15`, `Date.ParseISO: cannot parse 215-08-15`},
+		{`2015-07-11T00:00:00Z`, `Date.ParseISO: cannot parse 2015-07-11T00:00:00Z (unexpected time of day)`},
 	}
 	for _, c := range cases {
 		var d date.Date
@@ -353,3 +1421,96 @@ func TestInvalidText(t *testing.T) {
 		}
 	}
 }
+
+// csvMarshaler and csvUnmarshaler are the interfaces used by CSV struct
+// mapping libraries such as github.com/gocarina/gocsv.
+type csvMarshaler interface {
+	MarshalCSV() (string, error)
+}
+
+type csvUnmarshaler interface {
+	UnmarshalCSV(string) error
+}
+
+func TestCSVMarshalling(t *testing.T) {
+	type event struct {
+		Name string
+		Date date.Date
+	}
+	events := []event{
+		{"launch", date.New(2015, time.July, 11)},
+		{"epoch", date.Date{}},
+		{"far future", date.New(12345, time.June, 7)},
+		{"far past", date.New(-987, time.June, 5)},
+	}
+
+	// Write the events as gocsv would, through the csvMarshaler interface
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	for _, e := range events {
+		var m csvMarshaler = e.Date
+		field, err := m.MarshalCSV()
+		if err != nil {
+			t.Fatalf("MarshalCSV(%v) error %v", e.Date, err)
+		}
+		w.Write([]string{e.Name, field})
+	}
+	w.Flush()
+	want := "launch,2015-07-11\nepoch,1970-01-01\nfar future,+12345-06-07\nfar past,-0987-06-05\n"
+	if buf.String() != want {
+		t.Errorf("MarshalCSV(%v) == %q, want %q", events, buf.String(), want)
+	}
+
+	// Read them back through the csvUnmarshaler interface, with a blank
+	// date field unmarshaling to the zero date
+	buf.WriteString("blank,\n")
+	events = append(events, event{"blank", date.Date{}})
+	records, err := csv.NewReader(strings.NewReader(buf.String())).ReadAll()
+	if err != nil {
+		t.Fatalf("ReadAll error %v", err)
+	}
+	for i, r := range records {
+		e := event{Name: r[0], Date: date.Today()}
+		var u csvUnmarshaler = &e.Date
+		if err := u.UnmarshalCSV(r[1]); err != nil {
+			t.Errorf("UnmarshalCSV(%q) error %v", r[1], err)
+		} else if e != events[i] {
+			t.Errorf("UnmarshalCSV(%q) == %v, want %v", r[1], e.Date, events[i].Date)
+		}
+	}
+
+	var d date.Date
+	if err := d.UnmarshalCSV("not-a-date"); err == nil {
+		t.Errorf("UnmarshalCSV(not-a-date) == %v, want error", d)
+	}
+}
+
+func TestCSVNullDate(t *testing.T) {
+	cases := []struct {
+		value date.NullDate
+		field string
+	}{
+		{date.NullDate{}, ""},
+		{date.NullDate{Date: date.Date{}, Valid: true}, "1970-01-01"},
+		{date.NullDate{Date: date.New(2015, time.July, 11), Valid: true}, "2015-07-11"},
+	}
+	for _, c := range cases {
+		var m csvMarshaler = c.value
+		field, err := m.MarshalCSV()
+		if err != nil || field != c.field {
+			t.Errorf("MarshalCSV(%v) == %q (%v), want %q", c.value, field, err, c.field)
+		}
+		n := date.NullDate{Date: date.Today(), Valid: !c.value.Valid}
+		var u csvUnmarshaler = &n
+		if err := u.UnmarshalCSV(c.field); err != nil {
+			t.Errorf("UnmarshalCSV(%q) error %v", c.field, err)
+		} else if n != c.value {
+			t.Errorf("UnmarshalCSV(%q) == %v, want %v", c.field, n, c.value)
+		}
+	}
+
+	n := date.NullDate{Date: date.Today(), Valid: true}
+	if err := n.UnmarshalCSV("not-a-date"); err == nil {
+		t.Errorf("UnmarshalCSV(not-a-date) == %v, want error", n)
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright 2015 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+import "fmt"
+
+// A DayCount specifies a day count convention used to compute the fraction
+// of a year between two dates, as used in financial calculations such as
+// interest accrual.
+//
+// References
+//
+// ISDA 2006 Definitions, Section 4.16 (Day Count Fraction)
+//
+// https://en.wikipedia.org/wiki/Day_count_convention
+type DayCount int
+
+// These are the supported day count conventions.
+const (
+	// Actual365Fixed (ACT/365 Fixed) divides the actual number of days
+	// by 365.
+	Actual365Fixed DayCount = iota
+	// Actual360 (ACT/360) divides the actual number of days by 360.
+	Actual360
+	// Thirty360 (30/360, also known as the Bond Basis) counts every month
+	// as 30 days: a day 31 is treated as day 30 for the start date, and for
+	// the end date only when the start date falls on day 30 or 31.
+	Thirty360
+	// ThirtyE360 (30E/360, also known as the Eurobond Basis) counts every
+	// month as 30 days, treating any day 31 as day 30.
+	ThirtyE360
+)
+
+var dayCountNames = [...]string{"ACT/365", "ACT/360", "30/360", "30E/360"}
+
+// String returns the conventional name of the day count convention
+// (e.g. "ACT/360").
+func (c DayCount) String() string {
+	if Actual365Fixed <= c && c <= ThirtyE360 {
+		return dayCountNames[c]
+	}
+	return fmt.Sprintf("%%!DayCount(%d)", int(c))
+}
+
+// YearFraction returns the fraction of a year from d to u according to the
+// given day count convention; the result is negative if u is before d.
+// YearFraction panics if the convention is not valid.
+func (d Date) YearFraction(u Date, convention DayCount) float64 {
+	switch convention {
+	case Actual365Fixed:
+		return float64(u.Sub(d)) / 365
+	case Actual360:
+		return float64(u.Sub(d)) / 360
+	case Thirty360, ThirtyE360:
+		y1, m1, d1 := d.Date()
+		y2, m2, d2 := u.Date()
+		if d1 == 31 {
+			d1 = 30
+		}
+		if d2 == 31 && (convention == ThirtyE360 || d1 == 30) {
+			d2 = 30
+		}
+		days := 360*(y2-y1) + 30*int(m2-m1) + d2 - d1
+		return float64(days) / 360
+	}
+	panic("date.YearFraction: invalid day count convention " + convention.String())
+}
@@ -0,0 +1,67 @@
+// Copyright 2015 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/fxtlabs/date"
+)
+
+func TestYearFraction(t *testing.T) {
+	cases := []struct {
+		start, end date.Date
+		convention date.DayCount
+		want       float64
+	}{
+		{date.New(2007, time.January, 15), date.New(2007, time.July, 15), date.Actual365Fixed, 181.0 / 365},
+		{date.New(2007, time.January, 15), date.New(2007, time.July, 15), date.Actual360, 181.0 / 360},
+		{date.New(2007, time.January, 15), date.New(2007, time.July, 15), date.Thirty360, 0.5},
+		{date.New(2007, time.January, 15), date.New(2007, time.July, 15), date.ThirtyE360, 0.5},
+		{date.New(2008, time.January, 1), date.New(2009, time.January, 1), date.Actual365Fixed, 366.0 / 365},
+		{date.New(2008, time.January, 1), date.New(2009, time.January, 1), date.Thirty360, 1},
+		{date.New(2007, time.September, 30), date.New(2008, time.March, 31), date.Thirty360, 0.5},
+		{date.New(2007, time.September, 30), date.New(2008, time.March, 31), date.ThirtyE360, 0.5},
+		{date.New(2008, time.February, 29), date.New(2008, time.March, 31), date.Thirty360, 32.0 / 360},
+		{date.New(2008, time.February, 29), date.New(2008, time.March, 31), date.ThirtyE360, 31.0 / 360},
+		{date.New(2007, time.August, 31), date.New(2008, time.February, 29), date.Thirty360, 179.0 / 360},
+		{date.New(2007, time.August, 31), date.New(2008, time.February, 29), date.Actual360, 182.0 / 360},
+		{date.New(2007, time.July, 15), date.New(2007, time.January, 15), date.Thirty360, -0.5},
+		{date.New(2007, time.July, 15), date.New(2007, time.January, 15), date.Actual365Fixed, -181.0 / 365},
+	}
+	for _, c := range cases {
+		f := c.start.YearFraction(c.end, c.convention)
+		if math.Abs(f-c.want) > 1e-12 {
+			t.Errorf("YearFraction(%v, %v, %v) == %v, want %v", c.start, c.end, c.convention, f, c.want)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("YearFraction with an invalid convention did not panic")
+		}
+	}()
+	date.Today().YearFraction(date.Today(), date.DayCount(42))
+}
+
+func TestDayCountString(t *testing.T) {
+	cases := []struct {
+		convention date.DayCount
+		want       string
+	}{
+		{date.Actual365Fixed, "ACT/365"},
+		{date.Actual360, "ACT/360"},
+		{date.Thirty360, "30/360"},
+		{date.ThirtyE360, "30E/360"},
+		{date.DayCount(42), "%!DayCount(42)"},
+	}
+	for _, c := range cases {
+		if s := c.convention.String(); s != c.want {
+			t.Errorf("String(%d) == %v, want %v", int(c.convention), s, c.want)
+		}
+	}
+}
@@ -5,7 +5,9 @@
 package date_test
 
 import (
+	"flag"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/fxtlabs/date"
@@ -67,6 +69,16 @@ func ExampleDate_AddDate() {
 	// Output: 1001-02-28
 }
 
+func ExampleDate_AddMonths() {
+	d := date.New(2016, time.January, 31)
+	// February 31 overflows into March unless clamped to the end of February.
+	fmt.Println(d.AddMonths(1, false))
+	fmt.Println(d.AddMonths(1, true))
+	// Output:
+	// 2016-03-02
+	// 2016-02-29
+}
+
 func ExampleDate_Format() {
 	// layout shows by example how the reference time should be represented.
 	const layout = "Jan 2, 2006"
@@ -83,3 +95,13 @@ func ExampleDate_FormatISO() {
 	fmt.Println(d.FormatISO(5))
 	// Output: -00752-04-21
 }
+
+func ExampleDateList() {
+	var dates date.DateList
+	fs := flag.NewFlagSet("report", flag.ExitOnError)
+	fs.Var(&dates, "date", "date to report on (repeatable)")
+	fs.Parse([]string{"-date", "2015-07-14", "-date", "2015-07-11", "-date", "2015-07-12,2015-07-13"})
+	sort.Sort(dates)
+	fmt.Println(dates)
+	// Output: 2015-07-11,2015-07-12,2015-07-13,2015-07-14
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"regexp"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -35,22 +36,59 @@ const (
 // ISO 8601 extended format, with or without an expanded year representation.
 var reISO8601 = regexp.MustCompile(`^([-+]?\d{4,})-(\d{2})-(\d{2})$`)
 
+// reISO8601Strict is the regular expression used by ParseISOStrict to parse
+// date strings in the ISO 8601 extended format; the year must be unsigned
+// with four digits, positive with a + sign and at least five digits, or
+// negative with a - sign and at least four digits.
+var reISO8601Strict = regexp.MustCompile(`^(\d{4}|\+\d{5,}|-\d{4,})-(\d{2})-(\d{2})$`)
+
+// reISO8601B is the regular expression used to parse date strings in the
+// ISO 8601 basic format, with or without an expanded year representation.
+// Unlike the extended format, an expanded year must carry a sign prefix
+// since there is no separator to tell the year and month digits apart.
+var reISO8601B = regexp.MustCompile(`^([-+]\d{4,}|\d{4})(\d{2})(\d{2})$`)
+
+// reOrdinal is the regular expression used to parse ordinal date strings in
+// the ISO 8601 extended format, with or without an expanded year
+// representation.
+var reOrdinal = regexp.MustCompile(`^([-+]?\d{4,})-(\d{3})$`)
+
+// reOrdinalB is the regular expression used to parse ordinal date strings in
+// the ISO 8601 basic format, with or without an expanded year representation.
+// As with reISO8601B, an expanded year must carry a sign prefix.
+var reOrdinalB = regexp.MustCompile(`^([-+]\d{4,}|\d{4})(\d{3})$`)
+
+// reISOWeek is the regular expression used to parse week date strings in the
+// ISO 8601 extended format, with or without an expanded year representation.
+var reISOWeek = regexp.MustCompile(`^([-+]?\d{4,})-W(\d{2})-(\d)$`)
+
 // ParseISO parses an ISO 8601 formatted string and returns the date value it represents.
 // In addition to the common extended format (e.g. 2006-01-02), this function
 // accepts date strings using the expanded year representation
 // with possibly extra year digits beyond the prescribed four-digit minimum
 // and with a + or - sign prefix (e.g. , "+12345-06-07", "-0987-06-05").
+// Date strings in the basic format (e.g. 20060102, +123450607) are accepted
+// as well.
 //
 // Note that ParseISO is a little looser than the ISO 8601 standard and will
 // be happy to parse dates with a year longer than the four-digit minimum even
 // if they are missing the + sign prefix.
 //
+// Strings carrying a time of day (e.g. "2006-01-02T15:04:05Z") are rejected;
+// use time.Parse and NewAt if the time of day must be taken into account.
+//
 // Function Date.Parse can be used to parse date strings in other formats, but it
 // is currently not able to parse ISO 8601 formatted strings that use the
 // expanded year format.
 func ParseISO(value string) (Date, error) {
 	m := reISO8601.FindStringSubmatch(value)
 	if len(m) != 4 {
+		m = reISO8601B.FindStringSubmatch(value)
+	}
+	if len(m) != 4 {
+		if i := strings.IndexByte(value, 'T'); i >= 0 && reISO8601.MatchString(value[:i]) {
+			return Date{}, fmt.Errorf("Date.ParseISO: cannot parse %s (unexpected time of day)", value)
+		}
 		return Date{}, fmt.Errorf("Date.ParseISO: cannot parse %s", value)
 	}
 	// No need to check for errors since the regexp guarantees the matches
@@ -64,6 +102,99 @@ func ParseISO(value string) (Date, error) {
 	return Date{encode(t)}, nil
 }
 
+// ParseISOStrict is like ParseISO, but it only accepts strings in the
+// ISO 8601 extended format and it enforces the standard representation of
+// years: years in the [0,9999] range use exactly four digits and no sign,
+// while larger years use the expanded representation with a + sign and at
+// least five digits (e.g. "+12345-06-07"). Negative years always carry
+// a - sign and use at least four digits (e.g. "-0001-01-01").
+// Dates outside their month (e.g. "2015-02-30") are rejected as well.
+//
+// ParseISOStrict accepts every string produced by Date.String.
+func ParseISOStrict(value string) (Date, error) {
+	m := reISO8601Strict.FindStringSubmatch(value)
+	if len(m) != 4 {
+		return Date{}, fmt.Errorf("Date.ParseISOStrict: cannot parse %s", value)
+	}
+	// No need to check for errors since the regexp guarantees the matches
+	// are valid integers
+	year, _ := strconv.Atoi(m[1])
+	month, _ := strconv.Atoi(m[2])
+	day, _ := strconv.Atoi(m[3])
+
+	d, err := NewValid(year, time.Month(month), day)
+	if err != nil {
+		return Date{}, fmt.Errorf("Date.ParseISOStrict: cannot parse %s (invalid date)", value)
+	}
+	return d, nil
+}
+
+// MustParseISO is like ParseISO but panics if the string cannot be parsed.
+// It simplifies safe initialization of global variables holding dates.
+func MustParseISO(value string) Date {
+	d, err := ParseISO(value)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// ParseOrdinal parses an ISO 8601 ordinal date string in extended format
+// (e.g. 2006-002) or basic format (e.g. 2006002) and returns the date value
+// it represents. As with ParseISO, the year may use the expanded
+// representation (e.g. "+12345-158" or "+12345158").
+// Unlike FromYearDay, the day of the year must be within the valid range
+// for the year: [1,365] for non-leap years and [1,366] for leap years.
+func ParseOrdinal(value string) (Date, error) {
+	m := reOrdinal.FindStringSubmatch(value)
+	if len(m) != 3 {
+		m = reOrdinalB.FindStringSubmatch(value)
+	}
+	if len(m) != 3 {
+		return Date{}, fmt.Errorf("Date.ParseOrdinal: cannot parse %s", value)
+	}
+	// No need to check for errors since the regexp guarantees the matches
+	// are valid integers
+	year, _ := strconv.Atoi(m[1])
+	day, _ := strconv.Atoi(m[2])
+
+	d := FromYearDay(year, day)
+	if day < 1 || d.Year() != year {
+		return Date{}, fmt.Errorf("Date.ParseOrdinal: day out of range in %s", value)
+	}
+	return d, nil
+}
+
+// ParseISOWeek parses an ISO 8601 week date string (e.g. 2006-W01-1) and
+// returns the date value it represents. The year is the ISO week-numbering
+// year returned by Date.ISOWeek, which may differ from the calendar year
+// for dates in early January and late December (e.g. 2020-W53-5 is
+// January 1, 2021); the day is the ISO day of the week, from 1 (Monday)
+// to 7 (Sunday). As with ParseISO, the year may use the expanded
+// representation (e.g. "+12345-W23-4").
+func ParseISOWeek(value string) (Date, error) {
+	m := reISOWeek.FindStringSubmatch(value)
+	if len(m) != 4 {
+		return Date{}, fmt.Errorf("Date.ParseISOWeek: cannot parse %s", value)
+	}
+	// No need to check for errors since the regexp guarantees the matches
+	// are valid integers
+	year, _ := strconv.Atoi(m[1])
+	week, _ := strconv.Atoi(m[2])
+	day, _ := strconv.Atoi(m[3])
+	if week < 1 || day < 1 || day > 7 {
+		return Date{}, fmt.Errorf("Date.ParseISOWeek: week or day out of range in %s", value)
+	}
+
+	// January 4 always falls in the first week of the ISO year
+	start := New(year, time.January, 4).StartOfWeek(time.Monday)
+	d := start.Add((week-1)*7 + day - 1)
+	if y, _ := d.ISOWeek(); y != year {
+		return Date{}, fmt.Errorf("Date.ParseISOWeek: week or day out of range in %s", value)
+	}
+	return d, nil
+}
+
 // Parse parses a formatted string and returns the Date value it represents.
 // The layout defines the format by showing how the reference date, defined
 // to be
@@ -85,7 +216,107 @@ func Parse(layout, value string) (Date, error) {
 	return Date{encode(t)}, nil
 }
 
-// String returns the time formatted in ISO 8601 extended format
+// ParseInLocation is like Parse but, like time.ParseInLocation, it
+// interprets a time without time zone information as being in the given
+// location, and it returns the date of the parsed instant as observed in
+// that location. When the layout includes a time and a zone, the result may
+// therefore differ by a day from the date written in value; for example,
+// "2015-07-11 23:00 -0500" falls on July 12 in UTC.
+//
+// When the layout has no zone, the location still governs how the value is
+// interpreted, but the result is then the date written in value.
+func ParseInLocation(layout, value string, loc *time.Location) (Date, error) {
+	t, err := time.ParseInLocation(layout, value, loc)
+	if err != nil {
+		return Date{0}, err
+	}
+	return NewAt(t.In(loc)), nil
+}
+
+// parseAny parses value using each of the given layouts in turn and returns
+// the date of the first successful parse, taken in the time zone given in
+// value, if any.
+func parseAny(name, value string, layouts ...string) (Date, error) {
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return NewAt(t), nil
+		}
+	}
+	return Date{}, fmt.Errorf("Date.%s: cannot parse %s", name, value)
+}
+
+// ParseRFC822 parses an RFC 822 timestamp (e.g. "Mon, 02 Jan 06 15:04 MST")
+// and returns its date, discarding the time of day and the time zone.
+// The day of the week is optional and the time zone can be given either as
+// a name or as a numeric offset. Date-only strings in the RFC822 and
+// RFC822W layouts are accepted as well.
+//
+// Two-digit years follow the same pivot rule as time.Parse: years 69 to 99
+// are in the 20th century and years 00 to 68 are in the 21st.
+func ParseRFC822(value string) (Date, error) {
+	return parseAny("ParseRFC822", value,
+		"Mon, 02 Jan 06 15:04 MST", "Mon, 02 Jan 06 15:04 -0700",
+		time.RFC822, time.RFC822Z, RFC822W, RFC822)
+}
+
+// ParseRFC850 parses an RFC 850 timestamp (e.g. "Monday, 02-Jan-06 15:04:05 MST")
+// and returns its date, discarding the time of day and the time zone.
+// Date-only strings in the RFC850 layout are accepted as well.
+//
+// Two-digit years follow the same pivot rule as ParseRFC822.
+func ParseRFC850(value string) (Date, error) {
+	return parseAny("ParseRFC850", value, time.RFC850, RFC850)
+}
+
+// ParseRFC1123 parses an RFC 1123 timestamp (e.g. "Mon, 02 Jan 2006 15:04:05 MST",
+// as used in HTTP headers such as Last-Modified) and returns its date,
+// discarding the time of day and the time zone.
+// The time zone can be given either as a name or as a numeric offset.
+// Date-only strings in the RFC1123 and RFC1123W layouts are accepted as well.
+func ParseRFC1123(value string) (Date, error) {
+	return parseAny("ParseRFC1123", value,
+		time.RFC1123, time.RFC1123Z, RFC1123W, RFC1123)
+}
+
+// ParseAny parses a date string in any of a few common formats and returns
+// the Date value it represents. The formats are tried in this order:
+//     ISO 8601, as accepted by ParseISO (e.g. "2006-01-02", "20060102")
+//     MM/DD/YYYY (e.g. "01/02/2006" or "1/2/2006")
+//     DD/MM/YYYY (e.g. "02/01/2006" or "2/1/2006")
+//     RFC 1123, as accepted by ParseRFC1123 (e.g. "Mon, 02 Jan 2006")
+// so that ambiguous strings such as "03/04/2015" are read month first
+// (March 4, 2015); use ParseAnyDayFirst to read them day first instead.
+// The returned error lists the formats that were tried.
+func ParseAny(value string) (Date, error) {
+	return parseAnyOrder("ParseAny", value, "MM/DD/YYYY", "1/2/2006", "DD/MM/YYYY", "2/1/2006")
+}
+
+// ParseAnyDayFirst is like ParseAny but tries the DD/MM/YYYY format before
+// the MM/DD/YYYY format, so that ambiguous strings such as "03/04/2015" are
+// read day first (April 3, 2015).
+func ParseAnyDayFirst(value string) (Date, error) {
+	return parseAnyOrder("ParseAnyDayFirst", value, "DD/MM/YYYY", "2/1/2006", "MM/DD/YYYY", "1/2/2006")
+}
+
+// parseAnyOrder implements ParseAny and ParseAnyDayFirst, trying the two
+// given slash-separated layouts in turn after ISO 8601.
+func parseAnyOrder(name, value, name1, layout1, name2, layout2 string) (Date, error) {
+	if d, err := ParseISO(value); err == nil {
+		return d, nil
+	}
+	if d, err := Parse(layout1, value); err == nil {
+		return d, nil
+	}
+	if d, err := Parse(layout2, value); err == nil {
+		return d, nil
+	}
+	if d, err := ParseRFC1123(value); err == nil {
+		return d, nil
+	}
+	return Date{}, fmt.Errorf("Date.%s: cannot parse %s (tried ISO 8601, %s, %s, RFC 1123)", name, value, name1, name2)
+}
+
+// String returns the date formatted in ISO 8601 extended format
 // (e.g. "2006-01-02").  If the year of the date falls outside the
 // [0,9999] range, this format produces an expanded year representation
 // with possibly extra year digits beyond the prescribed four-digit minimum
@@ -117,6 +348,52 @@ func (d Date) FormatISO(yearDigits int) string {
 	return fmt.Sprintf("%+0*d-%02d-%02d", n, year, month, day)
 }
 
+// FormatBasic returns a textual representation of the date value formatted
+// in ISO 8601 basic format (e.g. "20060102"). If the year of the date falls
+// outside the [0,9999] range, this format produces an expanded year
+// representation with a + or - sign prefix (e.g. "+123450607"), which is
+// required to tell the year and month digits apart. The result can be
+// parsed with ParseISO.
+func (d Date) FormatBasic() string {
+	year, month, day := d.Date()
+	if 0 <= year && year < 10000 {
+		return fmt.Sprintf("%04d%02d%02d", year, month, day)
+	}
+	return fmt.Sprintf("%+05d%02d%02d", year, month, day)
+}
+
+// FormatCompact returns a textual representation of the date value formatted
+// as an ISO 8601 ordinal date in basic format (e.g. "2006002"), the shortest
+// standard representation of a complete date. As with FormatBasic, if the
+// year falls outside the [0,9999] range, this format produces an expanded
+// year representation with a + or - sign prefix (e.g. "+12345158").
+// The result can be parsed with ParseOrdinal, but not with ParseISO.
+func (d Date) FormatCompact() string {
+	year, day := d.OrdinalDate()
+	if 0 <= year && year < 10000 {
+		return fmt.Sprintf("%04d%03d", year, day)
+	}
+	return fmt.Sprintf("%+05d%03d", year, day)
+}
+
+// FormatISOWeek returns a textual representation of the date value formatted
+// as an ISO 8601 week date in extended format (e.g. "2006-W01-1").
+// As with String, if the ISO year falls outside the [0,9999] range, this
+// format produces an expanded year representation with a + or - sign prefix
+// (e.g. "+12345-W23-4").
+func (d Date) FormatISOWeek() string {
+	year, week := d.ISOWeek()
+	// ISO 8601 numbers the days of the week from 1 (Monday) to 7 (Sunday)
+	day := int(d.Weekday())
+	if day == 0 {
+		day = 7
+	}
+	if 0 <= year && year < 10000 {
+		return fmt.Sprintf("%04d-W%02d-%d", year, week, day)
+	}
+	return fmt.Sprintf("%+05d-W%02d-%d", year, week, day)
+}
+
 // Format returns a textual representation of the date value formatted according
 // to layout, which defines the format by showing how the reference date,
 // defined to be
@@ -127,6 +404,9 @@ func (d Date) FormatISO(yearDigits int) string {
 // This function actually uses time.Format to format the input and can use any
 // layout accepted by time.Format by extending its date to a time at
 // 00:00:00.000 UTC.
+// Only the year, month, day, and weekday elements of the layout are
+// meaningful; any time-of-day elements (e.g. "15:04:05") render as midnight
+// and any zone elements (e.g. "MST", "-0700") render as UTC.
 //
 // This function cannot currently format Date values according to the expanded
 // year variant of ISO 8601; you should use Date.FormatISO to that effect.
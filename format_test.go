@@ -42,6 +42,9 @@ func TestParseISO(t *testing.T) {
 		{"-30000-02-15", -30000, time.February, 15},
 		{"-0400000-05-16", -400000, time.May, 16},
 		{"-5000000-09-17", -5000000, time.September, 17},
+		{"12340506", 1234, time.May, 6},
+		{"+123450607", 12345, time.June, 7},
+		{"-00010911", -1, time.September, 11},
 	}
 	for _, c := range cases {
 		d, err := date.ParseISO(c.value)
@@ -59,7 +62,8 @@ func TestParseISO(t *testing.T) {
 		"1234-5-6",
 		"1234-05-6",
 		"1234-5-06",
-		"12340506",
+		"123450607",
+		"1234-0506",
 		"1234/05/06",
 		"1234-0A-06",
 		"1234-05-0B",
@@ -72,6 +76,7 @@ func TestParseISO(t *testing.T) {
 		"+10-11-12",
 		"+100-02-03",
 		"-123-05-06",
+		"2015-07-11T00:00:00Z",
 	}
 	for _, c := range badCases {
 		d, err := date.ParseISO(c)
@@ -81,6 +86,196 @@ func TestParseISO(t *testing.T) {
 	}
 }
 
+func TestParseISOStrict(t *testing.T) {
+	cases := []struct {
+		value string
+		year  int
+		month time.Month
+		day   int
+	}{
+		{"0000-01-01", 0, time.January, 1},
+		{"2015-07-11", 2015, time.July, 11},
+		{"9999-12-31", 9999, time.December, 31},
+		{"+10000-01-01", 10000, time.January, 1},
+		{"+12345-06-07", 12345, time.June, 7},
+		{"+012345-06-07", 12345, time.June, 7},
+		{"+5881580-07-11", 5881580, time.July, 11},
+		{"-0001-01-01", -1, time.January, 1},
+		{"-00001-01-01", -1, time.January, 1},
+		{"-9999-12-31", -9999, time.December, 31},
+		{"-12345-06-07", -12345, time.June, 7},
+		{"-5877641-06-23", -5877641, time.June, 23},
+	}
+	for _, c := range cases {
+		d, err := date.ParseISOStrict(c.value)
+		if err != nil {
+			t.Errorf("ParseISOStrict(%v) == %v", c.value, err)
+		}
+		year, month, day := d.Date()
+		if year != c.year || month != c.month || day != c.day {
+			t.Errorf("ParseISOStrict(%v) == %v, want (%v, %v, %v)", c.value, d, c.year, c.month, c.day)
+		}
+	}
+
+	badCases := []string{
+		"+2015-07-11",
+		"+0000-01-01",
+		"12345-06-07",
+		"012345-06-07",
+		"-001-01-01",
+		"-1-01-01",
+		"215-07-11",
+		"20150711",
+		"2015-02-30",
+		"2015-13-01",
+		"2015-00-10",
+		"2015-07-00",
+		"2015-07-11T00:00:00Z",
+	}
+	for _, c := range badCases {
+		d, err := date.ParseISOStrict(c)
+		if err == nil {
+			t.Errorf("ParseISOStrict(%v) == %v", c, d)
+		}
+	}
+
+	// Every string produced by String must be accepted
+	for _, d := range []date.Date{date.Min(), date.New(-1, time.December, 31), date.Date{}, date.New(12345, time.June, 7), date.Max()} {
+		u, err := date.ParseISOStrict(d.String())
+		if err != nil || u != d {
+			t.Errorf("ParseISOStrict(%v) == %v (%v), want %v", d.String(), u, err, d)
+		}
+	}
+}
+
+func TestMustParseISO(t *testing.T) {
+	d := date.MustParseISO("2015-07-11")
+	if d != date.New(2015, time.July, 11) {
+		t.Errorf("MustParseISO(2015-07-11) == %v, want 2015-07-11", d)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("MustParseISO(not-a-date) did not panic")
+		}
+	}()
+	date.MustParseISO("not-a-date")
+}
+
+func TestParseOrdinal(t *testing.T) {
+	cases := []struct {
+		value string
+		year  int
+		month time.Month
+		day   int
+	}{
+		{"2015-001", 2015, time.January, 1},
+		{"2015-192", 2015, time.July, 11},
+		{"2015-365", 2015, time.December, 31},
+		{"2016-060", 2016, time.February, 29},
+		{"2016-366", 2016, time.December, 31},
+		{"+12345-158", 12345, time.June, 7},
+		{"-0001-365", -1, time.December, 31},
+		{"0000-366", 0, time.December, 31},
+		{"2015001", 2015, time.January, 1},
+		{"2016366", 2016, time.December, 31},
+		{"+12345158", 12345, time.June, 7},
+		{"-0001365", -1, time.December, 31},
+	}
+	for _, c := range cases {
+		d, err := date.ParseOrdinal(c.value)
+		if err != nil {
+			t.Errorf("ParseOrdinal(%v) == %v", c.value, err)
+		}
+		year, month, day := d.Date()
+		if year != c.year || month != c.month || day != c.day {
+			t.Errorf("ParseOrdinal(%v) == %v, want (%v, %v, %v)", c.value, d, c.year, c.month, c.day)
+		}
+	}
+
+	badCases := []string{
+		"2015-000",
+		"2015-366",
+		"2016-367",
+		"2015-1",
+		"2015-01",
+		"2015-0001",
+		"2015000",
+		"2015366",
+		"201501",
+		"20150001",
+		"12345158",
+		"215-001",
+		"2015-07-11",
+	}
+	for _, c := range badCases {
+		d, err := date.ParseOrdinal(c)
+		if err == nil {
+			t.Errorf("ParseOrdinal(%v) == %v", c, d)
+		}
+	}
+}
+
+func TestISOWeek(t *testing.T) {
+	cases := []struct {
+		value string
+		want  date.Date
+	}{
+		{"2015-W28-6", date.New(2015, time.July, 11)},
+		{"2015-W01-1", date.New(2014, time.December, 29)},
+		{"2015-W53-7", date.New(2016, time.January, 3)},
+		{"2020-W53-4", date.New(2020, time.December, 31)},
+		{"2020-W53-5", date.New(2021, time.January, 1)},
+		{"2020-W53-7", date.New(2021, time.January, 3)},
+		{"2021-W01-1", date.New(2021, time.January, 4)},
+		{"2019-W01-1", date.New(2018, time.December, 31)},
+		{"2019-W52-7", date.New(2019, time.December, 29)},
+		{"2020-W01-1", date.New(2019, time.December, 30)},
+		{"0000-W01-1", date.New(0, time.January, 3)},
+		{"-0001-W52-7", date.New(0, time.January, 2)},
+		{"+12345-W23-4", date.New(12345, time.June, 7)},
+	}
+	for _, c := range cases {
+		d, err := date.ParseISOWeek(c.value)
+		if err != nil {
+			t.Errorf("ParseISOWeek(%v) == %v", c.value, err)
+		} else if d != c.want {
+			t.Errorf("ParseISOWeek(%v) == %v, want %v", c.value, d, c.want)
+		}
+		if value := c.want.FormatISOWeek(); value != c.value {
+			t.Errorf("FormatISOWeek(%v) == %v, want %v", c.want, value, c.value)
+		}
+	}
+
+	// Round-trip every day across a few year boundaries
+	start := date.New(2014, time.December, 1)
+	for i := 0; i < 3*366; i++ {
+		d := start.Add(i)
+		u, err := date.ParseISOWeek(d.FormatISOWeek())
+		if err != nil || u != d {
+			t.Errorf("ParseISOWeek(FormatISOWeek(%v)) == %v (%v)", d, u, err)
+		}
+	}
+
+	badCases := []string{
+		"2015-W00-1",
+		"2015-W54-1",
+		"2019-W53-1",
+		"2015-W01-0",
+		"2015-W01-8",
+		"2015-W1-1",
+		"2015W011",
+		"215-W01-1",
+		"2015-07-11",
+	}
+	for _, c := range badCases {
+		d, err := date.ParseISOWeek(c)
+		if err == nil {
+			t.Errorf("ParseISOWeek(%v) == %v", c, d)
+		}
+	}
+}
+
 func TestParse(t *testing.T) {
 	// Test ability to parse a few common date formats
 	cases := []struct {
@@ -125,6 +320,194 @@ func TestParse(t *testing.T) {
 	}
 }
 
+func TestParseInLocation(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+	newYork := time.FixedZone("EST", -5*60*60)
+	cases := []struct {
+		layout string
+		value  string
+		loc    *time.Location
+		want   date.Date
+	}{
+		{date.ISO8601, "2015-07-11", time.UTC, date.New(2015, time.July, 11)},
+		{date.ISO8601, "2015-07-11", tokyo, date.New(2015, time.July, 11)},
+		{date.ISO8601, "2015-07-11", newYork, date.New(2015, time.July, 11)},
+		{"2006-01-02 15:04", "2015-07-11 23:00", newYork, date.New(2015, time.July, 11)},
+		{"2006-01-02 15:04 -0700", "2015-07-11 23:00 -0500", newYork, date.New(2015, time.July, 11)},
+		{"2006-01-02 15:04 -0700", "2015-07-11 23:00 -0500", time.UTC, date.New(2015, time.July, 12)},
+		{"2006-01-02 15:04 -0700", "2015-07-11 23:00 -0500", tokyo, date.New(2015, time.July, 12)},
+		{time.RFC3339, "2015-07-11T01:00:00Z", newYork, date.New(2015, time.July, 10)},
+		{time.RFC3339, "2015-07-11T01:00:00Z", tokyo, date.New(2015, time.July, 11)},
+	}
+	for _, c := range cases {
+		d, err := date.ParseInLocation(c.layout, c.value, c.loc)
+		if err != nil {
+			t.Errorf("ParseInLocation(%v, %v) == %v", c.value, c.loc, err)
+		}
+		if d != c.want {
+			t.Errorf("ParseInLocation(%v, %v) == %v, want %v", c.value, c.loc, d, c.want)
+		}
+	}
+
+	badCases := []string{
+		"2015-07-11 23:00",
+		"2015-13-11",
+		"",
+	}
+	for _, c := range badCases {
+		d, err := date.ParseInLocation(date.ISO8601, c, time.UTC)
+		if err == nil {
+			t.Errorf("ParseInLocation(%v) == %v", c, d)
+		}
+	}
+}
+
+func TestParseRFC(t *testing.T) {
+	cases := []struct {
+		parse func(string) (date.Date, error)
+		name  string
+		value string
+		want  date.Date
+	}{
+		{date.ParseRFC822, "ParseRFC822", "Sat, 11 Jul 15 23:30 PDT", date.New(2015, time.July, 11)},
+		{date.ParseRFC822, "ParseRFC822", "Sat, 11 Jul 15 23:30 -0700", date.New(2015, time.July, 11)},
+		{date.ParseRFC822, "ParseRFC822", "11 Jul 15 00:30 +1000", date.New(2015, time.July, 11)},
+		{date.ParseRFC822, "ParseRFC822", "11 Jul 15 12:00 GMT", date.New(2015, time.July, 11)},
+		{date.ParseRFC822, "ParseRFC822", "Sat, 11-Jul-15", date.New(2015, time.July, 11)},
+		{date.ParseRFC822, "ParseRFC822", "11-Jul-69", date.New(1969, time.July, 11)},
+		{date.ParseRFC822, "ParseRFC822", "11-Jul-68", date.New(2068, time.July, 11)},
+		{date.ParseRFC850, "ParseRFC850", "Saturday, 11-Jul-15 23:30:00 PDT", date.New(2015, time.July, 11)},
+		{date.ParseRFC850, "ParseRFC850", "Friday, 11-Jul-99 00:00:00 GMT", date.New(1999, time.July, 11)},
+		{date.ParseRFC850, "ParseRFC850", "Saturday, 11-Jul-15", date.New(2015, time.July, 11)},
+		{date.ParseRFC1123, "ParseRFC1123", "Sat, 11 Jul 2015 23:59:59 GMT", date.New(2015, time.July, 11)},
+		{date.ParseRFC1123, "ParseRFC1123", "Sat, 11 Jul 2015 00:00:00 +1400", date.New(2015, time.July, 11)},
+		{date.ParseRFC1123, "ParseRFC1123", "Sat, 11 Jul 2015", date.New(2015, time.July, 11)},
+		{date.ParseRFC1123, "ParseRFC1123", "11 Jul 2015", date.New(2015, time.July, 11)},
+	}
+	for _, c := range cases {
+		d, err := c.parse(c.value)
+		if err != nil {
+			t.Errorf("%s(%v) == %v", c.name, c.value, err)
+		} else if d != c.want {
+			t.Errorf("%s(%v) == %v, want %v", c.name, c.value, d, c.want)
+		}
+	}
+
+	badCases := []struct {
+		parse func(string) (date.Date, error)
+		value string
+		want  string
+	}{
+		{date.ParseRFC822, "2015-07-11", "Date.ParseRFC822: cannot parse 2015-07-11"},
+		{date.ParseRFC850, "Sat, 11 Jul 2015 23:59:59 GMT", "Date.ParseRFC850: cannot parse Sat, 11 Jul 2015 23:59:59 GMT"},
+		{date.ParseRFC1123, "Saturday, 11-Jul-15 23:30:00 PDT", "Date.ParseRFC1123: cannot parse Saturday, 11-Jul-15 23:30:00 PDT"},
+		{date.ParseRFC1123, "Sat, 31 Jun 2015 00:00:00 GMT", "Date.ParseRFC1123: cannot parse Sat, 31 Jun 2015 00:00:00 GMT"},
+	}
+	for _, c := range badCases {
+		d, err := c.parse(c.value)
+		if err == nil || err.Error() != c.want {
+			t.Errorf("Parse(%v) == %v (%v), want %v", c.value, d, err, c.want)
+		}
+	}
+}
+
+func TestParseAny(t *testing.T) {
+	cases := []struct {
+		value                string
+		monthFirst, dayFirst date.Date
+	}{
+		{"2015-07-11", date.New(2015, time.July, 11), date.New(2015, time.July, 11)},
+		{"+12345-06-07", date.New(12345, time.June, 7), date.New(12345, time.June, 7)},
+		{"20150711", date.New(2015, time.July, 11), date.New(2015, time.July, 11)},
+		{"03/04/2015", date.New(2015, time.March, 4), date.New(2015, time.April, 3)},
+		{"3/4/2015", date.New(2015, time.March, 4), date.New(2015, time.April, 3)},
+		{"07/11/2015", date.New(2015, time.July, 11), date.New(2015, time.November, 7)},
+		{"07/13/2015", date.New(2015, time.July, 13), date.New(2015, time.July, 13)},
+		{"13/07/2015", date.New(2015, time.July, 13), date.New(2015, time.July, 13)},
+		{"Sat, 11 Jul 2015", date.New(2015, time.July, 11), date.New(2015, time.July, 11)},
+		{"11 Jul 2015", date.New(2015, time.July, 11), date.New(2015, time.July, 11)},
+		{"Sat, 11 Jul 2015 08:00:00 GMT", date.New(2015, time.July, 11), date.New(2015, time.July, 11)},
+	}
+	for _, c := range cases {
+		d, err := date.ParseAny(c.value)
+		if err != nil {
+			t.Errorf("ParseAny(%v) == %v", c.value, err)
+		} else if d != c.monthFirst {
+			t.Errorf("ParseAny(%v) == %v, want %v", c.value, d, c.monthFirst)
+		}
+		d, err = date.ParseAnyDayFirst(c.value)
+		if err != nil {
+			t.Errorf("ParseAnyDayFirst(%v) == %v", c.value, err)
+		} else if d != c.dayFirst {
+			t.Errorf("ParseAnyDayFirst(%v) == %v, want %v", c.value, d, c.dayFirst)
+		}
+	}
+
+	badCases := []struct {
+		value string
+		want  string
+	}{
+		{"13/13/2015", "Date.ParseAny: cannot parse 13/13/2015 (tried ISO 8601, MM/DD/YYYY, DD/MM/YYYY, RFC 1123)"},
+		{"2015/07/11", "Date.ParseAny: cannot parse 2015/07/11 (tried ISO 8601, MM/DD/YYYY, DD/MM/YYYY, RFC 1123)"},
+		{"July 11, 2015", "Date.ParseAny: cannot parse July 11, 2015 (tried ISO 8601, MM/DD/YYYY, DD/MM/YYYY, RFC 1123)"},
+	}
+	for _, c := range badCases {
+		d, err := date.ParseAny(c.value)
+		if err == nil || err.Error() != c.want {
+			t.Errorf("ParseAny(%v) == %v (%v), want %v", c.value, d, err, c.want)
+		}
+	}
+	if _, err := date.ParseAnyDayFirst("13/13/2015"); err == nil ||
+		err.Error() != "Date.ParseAnyDayFirst: cannot parse 13/13/2015 (tried ISO 8601, DD/MM/YYYY, MM/DD/YYYY, RFC 1123)" {
+		t.Errorf("ParseAnyDayFirst(13/13/2015) error == %v", err)
+	}
+}
+
+func TestString(t *testing.T) {
+	cases := []struct {
+		value date.Date
+		want  string
+	}{
+		{date.Min(), "-5877641-06-23"},
+		{date.New(-1, time.December, 31), "-0001-12-31"},
+		{date.New(0, time.January, 1), "0000-01-01"},
+		{date.Date{}, "1970-01-01"},
+		{date.New(9999, time.December, 31), "9999-12-31"},
+		{date.New(10000, time.January, 1), "+10000-01-01"},
+		{date.Max(), "+5881580-07-11"},
+	}
+	for _, c := range cases {
+		value := c.value.String()
+		if value != c.want {
+			t.Errorf("String(%v) == %v, want %v", c.value, value, c.want)
+		}
+		d, err := date.ParseISO(value)
+		if err != nil || d != c.value {
+			t.Errorf("ParseISO(String(%v)) == %v (%v), want %v", c.want, d, err, c.want)
+		}
+	}
+}
+
+func TestFormat(t *testing.T) {
+	cases := []struct {
+		layout string
+		want   string
+	}{
+		{date.RFC1123W, "Sat, 11 Jul 2015"},
+		{"2 January 2006", "11 July 2015"},
+		{"2006-01-02 15:04:05", "2015-07-11 00:00:00"},
+		{"Jan 2, 2006 3:04PM MST", "Jul 11, 2015 12:00AM UTC"},
+		{"2006-01-02T15:04:05Z07:00", "2015-07-11T00:00:00Z"},
+	}
+	d := date.New(2015, time.July, 11)
+	for _, c := range cases {
+		value := d.Format(c.layout)
+		if value != c.want {
+			t.Errorf("Format(%v) == %v, want %v", c.layout, value, c.want)
+		}
+	}
+}
+
 func TestFormatISO(t *testing.T) {
 	cases := []struct {
 		value string
@@ -153,3 +536,35 @@ func TestFormatISO(t *testing.T) {
 		}
 	}
 }
+
+func TestFormatBasicCompact(t *testing.T) {
+	cases := []struct {
+		value          date.Date
+		basic, compact string
+	}{
+		{date.New(2015, time.July, 11), "20150711", "2015192"},
+		{date.New(2016, time.December, 31), "20161231", "2016366"},
+		{date.New(0, time.January, 1), "00000101", "0000001"},
+		{date.New(9999, time.December, 31), "99991231", "9999365"},
+		{date.New(10000, time.January, 1), "+100000101", "+10000001"},
+		{date.New(12345, time.June, 7), "+123450607", "+12345158"},
+		{date.New(-1, time.December, 31), "-00011231", "-0001365"},
+		{date.New(-12345, time.June, 7), "-123450607", "-12345158"},
+		{date.Min(), "-58776410623", "-5877641174"},
+		{date.Max(), "+58815800711", "+5881580193"},
+	}
+	for _, c := range cases {
+		if s := c.value.FormatBasic(); s != c.basic {
+			t.Errorf("FormatBasic(%v) == %v, want %v", c.value, s, c.basic)
+		}
+		if d, err := date.ParseISO(c.basic); err != nil || d != c.value {
+			t.Errorf("ParseISO(%v) == %v (%v), want %v", c.basic, d, err, c.value)
+		}
+		if s := c.value.FormatCompact(); s != c.compact {
+			t.Errorf("FormatCompact(%v) == %v, want %v", c.value, s, c.compact)
+		}
+		if d, err := date.ParseOrdinal(c.compact); err != nil || d != c.value {
+			t.Errorf("ParseOrdinal(%v) == %v (%v), want %v", c.compact, d, err, c.value)
+		}
+	}
+}
@@ -0,0 +1,24 @@
+// Copyright 2015 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.18
+
+package date
+
+import (
+	"math"
+	"testing"
+)
+
+func FuzzWeekday(f *testing.F) {
+	for _, day := range []int32{math.MinInt32, -8, -7, -1, 0, 1, 7, 16627, math.MaxInt32} {
+		f.Add(day)
+	}
+	f.Fuzz(func(t *testing.T, day int32) {
+		d := Date{day}
+		if w, want := d.Weekday(), decode(day).Weekday(); w != want {
+			t.Errorf("Weekday(%v) == %v, want %v", day, w, want)
+		}
+	})
+}
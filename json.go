@@ -0,0 +1,111 @@
+// Copyright 2015 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// JSONEpochDays is a special layout for JSONDate that represents the date
+// as a JSON number giving the days elapsed since January 1, 1970
+// (see Date.DaysSinceEpoch).
+const JSONEpochDays = "epochdays"
+
+// A JSONDate is a Date that is marshaled to and unmarshaled from JSON
+// according to a custom layout.
+//
+// If Layout is empty, the date is represented as with Date.MarshalJSON and
+// Date.UnmarshalJSON, i.e. as a quoted string in ISO 8601 extended format.
+// If Layout is JSONEpochDays, the date is represented as a JSON number
+// giving the days elapsed since January 1, 1970. Otherwise, the date is
+// represented as a quoted string formatted with Date.Format and parsed with
+// Parse using Layout (e.g. "2006/01/02").
+//
+// When unmarshaling, the Layout already set in the destination value is
+// used; it is left unchanged.
+type JSONDate struct {
+	Date
+	Layout string
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (d JSONDate) MarshalJSON() ([]byte, error) {
+	switch d.Layout {
+	case "":
+		return d.Date.MarshalJSON()
+	case JSONEpochDays:
+		return d.marshalEpochDays(), nil
+	}
+	return []byte(strconv.Quote(d.Format(d.Layout))), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// As with Date.UnmarshalJSON, the JSON null value leaves the date unchanged.
+func (d *JSONDate) UnmarshalJSON(data []byte) error {
+	value := string(data)
+	switch {
+	case value == "null":
+		return nil
+	case d.Layout == "":
+		return d.Date.UnmarshalJSON(data)
+	case d.Layout == JSONEpochDays:
+		return d.unmarshalEpochDays("JSONDate.UnmarshalJSON", value)
+	}
+	n := len(value)
+	if n < 2 || value[0] != '"' || value[n-1] != '"' {
+		return fmt.Errorf("JSONDate.UnmarshalJSON: missing double quotes (%s)", value)
+	}
+	u, err := Parse(d.Layout, value[1:n-1])
+	if err != nil {
+		return err
+	}
+	d.day = u.day
+	return nil
+}
+
+// An EpochDate is a Date that is marshaled to and unmarshaled from JSON as
+// a number giving the days elapsed since January 1, 1970 (see
+// Date.DaysSinceEpoch), e.g. 16627 for July 11, 2015. Convert between the
+// two types as needed:
+//     e := date.EpochDate(d)
+//     d := date.Date(e)
+type EpochDate Date
+
+// MarshalJSON implements the json.Marshaler interface.
+func (e EpochDate) MarshalJSON() ([]byte, error) {
+	return Date(e).marshalEpochDays(), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The value must be an integer within the range of int32; as with
+// Date.UnmarshalJSON, the JSON null value leaves the date unchanged.
+func (e *EpochDate) UnmarshalJSON(data []byte) error {
+	value := string(data)
+	if value == "null" {
+		return nil
+	}
+	return (*Date)(e).unmarshalEpochDays("EpochDate.UnmarshalJSON", value)
+}
+
+// marshalEpochDays returns the days elapsed from date zero to d as a
+// JSON number.
+func (d Date) marshalEpochDays() []byte {
+	return []byte(strconv.FormatInt(int64(d.day), 10))
+}
+
+// unmarshalEpochDays sets d from a JSON number giving the days elapsed
+// since date zero; name identifies the caller in error messages.
+func (d *Date) unmarshalEpochDays(name, value string) error {
+	days, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		if ne, ok := err.(*strconv.NumError); ok && ne.Err == strconv.ErrRange {
+			return fmt.Errorf("%s: epoch days out of range (%s)", name, value)
+		}
+		return fmt.Errorf("%s: cannot parse %s as epoch days", name, value)
+	}
+	d.day = int32(days)
+	return nil
+}
@@ -0,0 +1,143 @@
+// Copyright 2015 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/fxtlabs/date"
+)
+
+func TestJSONDate(t *testing.T) {
+	cases := []struct {
+		value  date.Date
+		layout string
+		want   string
+	}{
+		{date.New(2015, time.July, 11), "", `"2015-07-11"`},
+		{date.New(12345, time.June, 7), "", `"+12345-06-07"`},
+		{date.New(2015, time.July, 11), date.JSONEpochDays, `16627`},
+		{date.New(1969, time.December, 31), date.JSONEpochDays, `-1`},
+		{date.Min(), date.JSONEpochDays, `-2147483648`},
+		{date.Max(), date.JSONEpochDays, `2147483647`},
+		{date.New(2015, time.July, 11), "2006/01/02", `"2015/07/11"`},
+		{date.New(1999, time.December, 31), "2006/01/02", `"1999/12/31"`},
+		{date.New(2015, time.July, 11), date.RFC1123W, `"Sat, 11 Jul 2015"`},
+	}
+	for _, c := range cases {
+		bytes, err := json.Marshal(date.JSONDate{Date: c.value, Layout: c.layout})
+		if err != nil {
+			t.Errorf("JSONDate(%v, %q) marshal error %v", c.value, c.layout, err)
+		} else if string(bytes) != c.want {
+			t.Errorf("JSONDate(%v, %q) == %v, want %v", c.value, c.layout, string(bytes), c.want)
+		} else {
+			d := date.JSONDate{Layout: c.layout}
+			err = json.Unmarshal(bytes, &d)
+			if err != nil {
+				t.Errorf("JSONDate(%v, %q) unmarshal error %v", c.value, c.layout, err)
+			} else if d.Date != c.value || d.Layout != c.layout {
+				t.Errorf("JSONDate(%v, %q) unmarshal == %v, %q", c.value, c.layout, d.Date, d.Layout)
+			}
+		}
+	}
+
+	// Test the layout within a struct and a null value
+	var s struct {
+		D date.JSONDate
+		N date.JSONDate
+	}
+	s.D.Layout = "2006/01/02"
+	s.N.Layout = date.JSONEpochDays
+	err := json.Unmarshal([]byte(`{"D":"2015/07/11","N":null}`), &s)
+	if err != nil {
+		t.Errorf("JSONDate unmarshal error %v", err)
+	} else if s.D.Date != date.New(2015, time.July, 11) || !s.N.IsZero() {
+		t.Errorf("JSONDate unmarshal == %v, %v", s.D.Date, s.N.Date)
+	}
+}
+
+func TestInvalidJSONDate(t *testing.T) {
+	cases := []struct {
+		value  string
+		layout string
+	}{
+		{`"2015-07-11"`, date.JSONEpochDays},
+		{`2147483648`, date.JSONEpochDays},
+		{`-2147483649`, date.JSONEpochDays},
+		{`1.5`, date.JSONEpochDays},
+		{`"2015-07-11"`, "2006/01/02"},
+		{`2015/07/11`, "2006/01/02"},
+		{`16627`, ""},
+	}
+	for _, c := range cases {
+		d := date.JSONDate{Layout: c.layout}
+		err := json.Unmarshal([]byte(c.value), &d)
+		if err == nil {
+			t.Errorf("JSONDate(%v, %q) == %v, want error", c.value, c.layout, d.Date)
+		}
+	}
+}
+
+func TestEpochDate(t *testing.T) {
+	cases := []struct {
+		value date.Date
+		want  string
+	}{
+		{date.Min(), `-2147483648`},
+		{date.New(1969, time.December, 31), `-1`},
+		{date.Date{}, `0`},
+		{date.New(2015, time.July, 11), `16627`},
+		{date.Max(), `2147483647`},
+	}
+	for _, c := range cases {
+		bytes, err := json.Marshal(date.EpochDate(c.value))
+		if err != nil {
+			t.Errorf("EpochDate(%v) marshal error %v", c.value, err)
+		} else if string(bytes) != c.want {
+			t.Errorf("EpochDate(%v) == %v, want %v", c.value, string(bytes), c.want)
+		} else {
+			var e date.EpochDate
+			err = json.Unmarshal(bytes, &e)
+			if err != nil {
+				t.Errorf("EpochDate(%v) unmarshal error %v", c.value, err)
+			} else if date.Date(e) != c.value {
+				t.Errorf("EpochDate(%v) unmarshal == %v, want %v", c.value, date.Date(e), c.value)
+			}
+		}
+	}
+
+	// Test a struct with a null value
+	var s struct {
+		E date.EpochDate
+		N date.EpochDate
+	}
+	err := json.Unmarshal([]byte(`{"E":16627,"N":null}`), &s)
+	if err != nil {
+		t.Errorf("EpochDate unmarshal error %v", err)
+	} else if date.Date(s.E) != date.New(2015, time.July, 11) || !date.Date(s.N).IsZero() {
+		t.Errorf("EpochDate unmarshal == %v, %v", date.Date(s.E), date.Date(s.N))
+	}
+}
+
+func TestInvalidEpochDate(t *testing.T) {
+	cases := []struct {
+		value string
+		want  string
+	}{
+		{`"2015-07-11"`, `EpochDate.UnmarshalJSON: cannot parse "2015-07-11" as epoch days`},
+		{`1.5`, `EpochDate.UnmarshalJSON: cannot parse 1.5 as epoch days`},
+		{`2147483648`, `EpochDate.UnmarshalJSON: epoch days out of range (2147483648)`},
+		{`-2147483649`, `EpochDate.UnmarshalJSON: epoch days out of range (-2147483649)`},
+	}
+	for _, c := range cases {
+		var e date.EpochDate
+		err := e.UnmarshalJSON([]byte(c.value))
+		if err == nil || err.Error() != c.want {
+			t.Errorf("InvalidEpochDate(%v) == %v, want %v", c.value, err, c.want)
+		}
+	}
+}
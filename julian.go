@@ -0,0 +1,48 @@
+// Copyright 2015 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+const (
+	// jdnOfDateZero is the Julian Day Number of date zero, January 1, 1970.
+	jdnOfDateZero = 2440588
+	// mjdOfDateZero is the Modified Julian Date of date zero.
+	mjdOfDateZero = 40587
+)
+
+// FromJulianDayNumber returns the Date value corresponding to the given
+// Julian Day Number, i.e. the number of days elapsed since Monday,
+// January 1, 4713 BC in the proleptic Julian calendar (November 24, -4713
+// in the proleptic Gregorian calendar used by Date).
+func FromJulianDayNumber(jdn int) Date {
+	return Date{int32(jdn - jdnOfDateZero)}
+}
+
+// JulianDayNumber returns the Julian Day Number of d, i.e. the number of
+// days elapsed since Monday, January 1, 4713 BC in the proleptic Julian
+// calendar. For example, the Julian Day Number of January 1, 2000 is
+// 2451545.
+//
+// The Julian Day Number of the dates near Max exceeds the range of a 32-bit
+// int, so the result is only exact over the full range of Date on platforms
+// with 64-bit ints.
+func (d Date) JulianDayNumber() int {
+	return int(d.day) + jdnOfDateZero
+}
+
+// FromModifiedJulianDate returns the Date value corresponding to the given
+// Modified Julian Date, i.e. the number of days elapsed since November 17,
+// 1858.
+func FromModifiedJulianDate(mjd int) Date {
+	return Date{int32(mjd - mjdOfDateZero)}
+}
+
+// ModifiedJulianDate returns the Modified Julian Date of d, i.e. the number
+// of days elapsed since November 17, 1858. It is equal to the Julian Date
+// at midnight starting d, JulianDayNumber() - 0.5, minus 2400000.5 or,
+// equivalently, to JulianDayNumber() - 2400001. For example, the Modified
+// Julian Date of January 1, 2000 is 51544.
+func (d Date) ModifiedJulianDate() int {
+	return int(d.day) + mjdOfDateZero
+}
@@ -0,0 +1,73 @@
+// Copyright 2015 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fxtlabs/date"
+)
+
+func TestJulianDayNumber(t *testing.T) {
+	cases := []struct {
+		value date.Date
+		jdn   int
+	}{
+		{date.New(-4713, time.November, 24), 0},
+		{date.New(-4713, time.November, 25), 1},
+		{date.New(0, time.January, 1), 1721060},
+		{date.New(1582, time.October, 15), 2299161},
+		{date.New(1858, time.November, 17), 2400001},
+		{date.New(1970, time.January, 1), 2440588},
+		{date.New(2000, time.January, 1), 2451545},
+		{date.New(2015, time.July, 11), 2457215},
+	}
+	for _, c := range cases {
+		if jdn := c.value.JulianDayNumber(); jdn != c.jdn {
+			t.Errorf("JulianDayNumber(%v) == %v, want %v", c.value, jdn, c.jdn)
+		}
+		if d := date.FromJulianDayNumber(c.jdn); d != c.value {
+			t.Errorf("FromJulianDayNumber(%v) == %v, want %v", c.jdn, d, c.value)
+		}
+	}
+
+	for _, d := range []date.Date{date.Min(), date.Max()} {
+		if u := date.FromJulianDayNumber(d.JulianDayNumber()); u != d {
+			t.Errorf("FromJulianDayNumber(JulianDayNumber(%v)) == %v", d, u)
+		}
+	}
+}
+
+func TestModifiedJulianDate(t *testing.T) {
+	cases := []struct {
+		value date.Date
+		mjd   int
+	}{
+		{date.New(1858, time.November, 16), -1},
+		{date.New(1858, time.November, 17), 0},
+		{date.New(1970, time.January, 1), 40587},
+		{date.New(2000, time.January, 1), 51544},
+		{date.New(2015, time.July, 11), 57214},
+	}
+	for _, c := range cases {
+		if mjd := c.value.ModifiedJulianDate(); mjd != c.mjd {
+			t.Errorf("ModifiedJulianDate(%v) == %v, want %v", c.value, mjd, c.mjd)
+		}
+		if d := date.FromModifiedJulianDate(c.mjd); d != c.value {
+			t.Errorf("FromModifiedJulianDate(%v) == %v, want %v", c.mjd, d, c.value)
+		}
+	}
+
+	// MJD and JDN must agree across the whole range
+	for _, d := range []date.Date{date.Min(), date.New(-4713, time.November, 24), date.New(2015, time.July, 11), date.Max()} {
+		if mjd, jdn := d.ModifiedJulianDate(), d.JulianDayNumber(); mjd != jdn-2400001 {
+			t.Errorf("ModifiedJulianDate(%v) == %v, want JulianDayNumber-2400001 == %v", d, mjd, jdn-2400001)
+		}
+		if u := date.FromModifiedJulianDate(d.ModifiedJulianDate()); u != date.FromJulianDayNumber(d.JulianDayNumber()) {
+			t.Errorf("FromModifiedJulianDate(ModifiedJulianDate(%v)) == %v", d, u)
+		}
+	}
+}
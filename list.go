@@ -0,0 +1,44 @@
+// Copyright 2015 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+import "strings"
+
+// A DateList is a list of dates. It implements sort.Interface, sorting the
+// dates in ascending order, and flag.Value, so that a command-line flag can
+// collect a date each time it is given:
+//     var dates date.DateList
+//     flag.Var(&dates, "date", "date to process (repeatable)")
+type DateList []Date
+
+func (l DateList) Len() int           { return len(l) }
+func (l DateList) Less(i, j int) bool { return l[i].day < l[j].day }
+func (l DateList) Swap(i, j int)      { l[i], l[j] = l[j], l[i] }
+
+// String returns the dates in the list formatted as by Date.String and
+// separated by commas (e.g. "2015-07-11,2015-07-14").
+func (l DateList) String() string {
+	s := make([]string, len(l))
+	for i, d := range l {
+		s[i] = d.String()
+	}
+	return strings.Join(s, ",")
+}
+
+// Set implements the flag.Value interface. It parses value as one or more
+// comma-separated dates in any format accepted by ParseISO and appends them
+// to the list; on error, the list is left unchanged.
+func (l *DateList) Set(value string) error {
+	var dates []Date
+	for _, s := range strings.Split(value, ",") {
+		d, err := ParseISO(s)
+		if err != nil {
+			return err
+		}
+		dates = append(dates, d)
+	}
+	*l = append(*l, dates...)
+	return nil
+}
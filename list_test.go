@@ -0,0 +1,61 @@
+// Copyright 2015 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date_test
+
+import (
+	"flag"
+	"io"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/fxtlabs/date"
+)
+
+func TestDateList(t *testing.T) {
+	var l date.DateList
+	if s := l.String(); s != "" {
+		t.Errorf("String(%v) == %q, want empty string", []date.Date(l), s)
+	}
+	for _, v := range []string{"2015-07-14", "2015-07-11,+12345-06-07", "19691231"} {
+		if err := l.Set(v); err != nil {
+			t.Errorf("Set(%v) error %v", v, err)
+		}
+	}
+	want := "2015-07-14,2015-07-11,+12345-06-07,1969-12-31"
+	if s := l.String(); s != want {
+		t.Errorf("String(%v) == %v, want %v", []date.Date(l), s, want)
+	}
+
+	sort.Sort(l)
+	sorted := []date.Date{
+		date.New(1969, time.December, 31),
+		date.New(2015, time.July, 11),
+		date.New(2015, time.July, 14),
+		date.New(12345, time.June, 7),
+	}
+	if !sameDates(l, sorted) {
+		t.Errorf("Sort(DateList) == %v, want %v", []date.Date(l), sorted)
+	}
+
+	// Invalid values leave the list unchanged
+	for _, v := range []string{"", "not-a-date", "2015-07-11,", "2015-07-11,2015-13"} {
+		if err := l.Set(v); err == nil {
+			t.Errorf("Set(%q) == %v, want error", v, []date.Date(l))
+		}
+	}
+	if !sameDates(l, sorted) {
+		t.Errorf("Set with invalid values changed list to %v", []date.Date(l))
+	}
+
+	// The flag package surfaces the errors returned by Set
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	var dates date.DateList
+	fs.Var(&dates, "date", "date")
+	if err := fs.Parse([]string{"-date", "2015-07-11", "-date", "July 11"}); err == nil {
+		t.Errorf("Parse(-date July 11) == %v, want error", []date.Date(dates))
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright 2015 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"fmt"
+	"time"
+)
+
+// ToProtoDate returns the year, month, and day of d as the fields of a
+// google.type.Date protocol buffer message. All three fields are always
+// specified (non-zero), since a Date is a full calendar date.
+//
+// The google.type.Date message is only defined for years from 1 to 9999;
+// for dates outside that range the returned year does not form a valid
+// message and should be checked by the caller.
+func (d Date) ToProtoDate() (year, month, day int32) {
+	y, m, dd := d.Date()
+	return int32(y), int32(m), int32(dd)
+}
+
+// FromProtoDate returns the Date value corresponding to the fields of a
+// google.type.Date protocol buffer message. The message allows a zero year,
+// month, or day to leave that field unspecified (e.g. a year and month for
+// a credit card expiration date, or a month and day for an anniversary);
+// FromProtoDate returns an error for such partial dates since they do not
+// identify a single Date. It also returns an error if the year is outside
+// the [1,9999] range defined by the message or if the month or day is out
+// of range.
+func FromProtoDate(year, month, day int32) (Date, error) {
+	if year == 0 || month == 0 || day == 0 {
+		return Date{}, fmt.Errorf("Date.FromProtoDate: partial date %04d-%02d-%02d has unspecified components", year, month, day)
+	}
+	if year < 1 || year > 9999 {
+		return Date{}, fmt.Errorf("Date.FromProtoDate: year %d out of range", year)
+	}
+	d, err := NewValid(int(year), time.Month(month), int(day))
+	if err != nil {
+		return Date{}, fmt.Errorf("Date.FromProtoDate: invalid date %04d-%02d-%02d", year, month, day)
+	}
+	return d, nil
+}
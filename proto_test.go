@@ -0,0 +1,61 @@
+// Copyright 2015 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fxtlabs/date"
+)
+
+func TestProtoDate(t *testing.T) {
+	cases := []struct {
+		value            date.Date
+		year, month, day int32
+	}{
+		{date.New(1, time.January, 1), 1, 1, 1},
+		{date.New(1970, time.January, 1), 1970, 1, 1},
+		{date.New(2015, time.July, 11), 2015, 7, 11},
+		{date.New(2016, time.February, 29), 2016, 2, 29},
+		{date.New(9999, time.December, 31), 9999, 12, 31},
+	}
+	for _, c := range cases {
+		year, month, day := c.value.ToProtoDate()
+		if year != c.year || month != c.month || day != c.day {
+			t.Errorf("ToProtoDate(%v) == (%v, %v, %v), want (%v, %v, %v)", c.value, year, month, day, c.year, c.month, c.day)
+		}
+		d, err := date.FromProtoDate(c.year, c.month, c.day)
+		if err != nil {
+			t.Errorf("FromProtoDate(%v, %v, %v) error %v", c.year, c.month, c.day, err)
+		} else if d != c.value {
+			t.Errorf("FromProtoDate(%v, %v, %v) == %v, want %v", c.year, c.month, c.day, d, c.value)
+		}
+	}
+
+	badCases := []struct {
+		year, month, day int32
+	}{
+		// Unspecified components
+		{0, 0, 0},
+		{0, 7, 11},
+		{2015, 7, 0},
+		{2015, 0, 0},
+		{2015, 0, 11},
+		// Out of range components
+		{-1, 7, 11},
+		{10000, 1, 1},
+		{2015, 13, 1},
+		{2015, -1, 1},
+		{2015, 2, 29},
+		{2015, 7, 32},
+	}
+	for _, c := range badCases {
+		d, err := date.FromProtoDate(c.year, c.month, c.day)
+		if err == nil {
+			t.Errorf("FromProtoDate(%v, %v, %v) == %v, want error", c.year, c.month, c.day, d)
+		}
+	}
+}
@@ -0,0 +1,261 @@
+// Copyright 2015 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// A Range represents a contiguous span of dates, from a start date to an
+// end date. Both the start and the end dates are included in the range, so
+// a range always contains at least one date.
+//
+// Like Date, Range values should be stored and passed as values, not
+// pointers.
+type Range struct {
+	start, end Date
+}
+
+// NewRange returns the Range of dates from start to end inclusive.
+// If end is before start, the two dates are swapped.
+func NewRange(start, end Date) Range {
+	if end.Before(start) {
+		start, end = end, start
+	}
+	return Range{start, end}
+}
+
+// YearRange returns the Range of dates in the given year, from January 1
+// to December 31.
+func YearRange(year int) Range {
+	return Range{New(year, time.January, 1), New(year, time.December, 31)}
+}
+
+// QuarterRange returns the Range of dates in the given calendar quarter of
+// the given year; the first quarter runs from January to March, and so on.
+// QuarterRange panics if the quarter is not in the range [1,4].
+func QuarterRange(year, quarter int) Range {
+	if quarter < 1 || quarter > 4 {
+		panic(fmt.Sprintf("date.QuarterRange: invalid quarter %d", quarter))
+	}
+	start := New(year, time.Month(3*quarter-2), 1)
+	return Range{start, start.LastDayOfQuarter()}
+}
+
+// MonthRange returns the Range of dates in the given month of the given
+// year. MonthRange panics if the month is not in the range [1,12].
+func MonthRange(year int, month time.Month) Range {
+	if month < time.January || month > time.December {
+		panic(fmt.Sprintf("date.MonthRange: invalid month %d", month))
+	}
+	start := New(year, month, 1)
+	return Range{start, start.LastDayOfMonth()}
+}
+
+// Start returns the first date of the range.
+func (r Range) Start() Date {
+	return r.start
+}
+
+// End returns the last date of the range.
+func (r Range) End() Date {
+	return r.end
+}
+
+// Days returns the number of days from the start to the end of the range.
+// Since both ends are included, the range contains Days()+1 dates.
+// If the range spans more than math.MaxInt32 days (e.g. from Min() to
+// Max()), the result does not fit in a PeriodOfDays and Days returns
+// math.MaxInt32 instead; use DaysChecked to detect this.
+func (r Range) Days() PeriodOfDays {
+	days, ok := r.DaysChecked()
+	if !ok {
+		return math.MaxInt32
+	}
+	return days
+}
+
+// DaysChecked returns the number of days from the start to the end of the
+// range, like Days, and whether the result fits in a PeriodOfDays. If it
+// does not, the returned number of days is not meaningful.
+func (r Range) DaysChecked() (PeriodOfDays, bool) {
+	return r.end.SubChecked(r.start)
+}
+
+// Contains reports whether d falls within the range.
+func (r Range) Contains(d Date) bool {
+	return !d.Before(r.start) && !d.After(r.end)
+}
+
+// Overlaps reports whether r and u have at least one date in common.
+func (r Range) Overlaps(u Range) bool {
+	return !r.end.Before(u.start) && !u.end.Before(r.start)
+}
+
+// Each calls f for each date in the range, in chronological order.
+func (r Range) Each(f func(d Date)) {
+	for d := r.start; ; d = d.Add(1) {
+		f(d)
+		if d == r.end {
+			break
+		}
+	}
+}
+
+// Dates returns all the dates in the range, in chronological order.
+// The returned slice holds one date per day of the range, taking 4 bytes each; for very
+// long ranges, use Each to visit the dates without allocating them all.
+func (r Range) Dates() []Date {
+	dates := make([]Date, 0, int64(r.end.day)-int64(r.start.day)+1)
+	r.Each(func(d Date) {
+		dates = append(dates, d)
+	})
+	return dates
+}
+
+// WeekendDays returns the number of Saturdays and Sundays in the range.
+// It takes constant time regardless of the length of the range.
+func (r Range) WeekendDays() int {
+	n := int64(r.end.day) - int64(r.start.day) + 1
+	count := n / 7 * 2
+	// Count the weekend days among the remaining days, at most six
+	for d := r.start; n%7 > 0; n-- {
+		if d.IsWeekend() {
+			count++
+		}
+		d = d.Add(1)
+	}
+	return int(count)
+}
+
+// Weekdays returns the number of days from Monday to Friday in the range.
+// It takes constant time regardless of the length of the range.
+func (r Range) Weekdays() int {
+	n := int64(r.end.day) - int64(r.start.day) + 1
+	return int(n) - r.WeekendDays()
+}
+
+// MonthStarts returns the first day of every month that the range touches,
+// in chronological order. The first date returned may precede the start of
+// the range; if the first month begins before Min(), its first day cannot be
+// represented and is omitted.
+func (r Range) MonthStarts() []Date {
+	return r.starts(1)
+}
+
+// QuarterStarts returns the first day of every quarter that the range
+// touches, in chronological order. The first date returned may precede the
+// start of the range; if the first quarter begins before Min(), its first day
+// cannot be represented and is omitted.
+func (r Range) QuarterStarts() []Date {
+	return r.starts(3)
+}
+
+// YearStarts returns the first day of every year that the range touches,
+// in chronological order. The first date returned may precede the start of
+// the range; if the first year begins before Min(), its first day cannot be
+// represented and is omitted.
+func (r Range) YearStarts() []Date {
+	return r.starts(12)
+}
+
+// starts returns the start of every unit of the given number of months,
+// aligned on January 1, touched by the range.
+func (r Range) starts(months int) []Date {
+	year, month, _ := r.start.Date()
+	month -= (month - 1) % time.Month(months)
+	d := New(year, month, 1)
+	if d.After(r.start) {
+		// The first unit starts before Min() and wrapped around, so begin
+		// with the next one
+		d = New(year, month+time.Month(months), 1)
+	}
+	var dates []Date
+	for !d.After(r.end) {
+		dates = append(dates, d)
+		next := d.AddDate(0, months, 0)
+		if !next.After(d) {
+			// Past the end of the representable dates
+			break
+		}
+		d = next
+	}
+	return dates
+}
+
+// Split splits the range into two ranges, the second of which starts on the
+// given date: the first range runs from the start of r to the day before at,
+// and the second from at to the end of r. Since a range cannot be empty,
+// Split returns false, along with r and the zero Range, unless at is after
+// the start of r and not after its end.
+func (r Range) Split(at Date) (Range, Range, bool) {
+	if !at.After(r.start) || at.After(r.end) {
+		return r, Range{}, false
+	}
+	return Range{r.start, at.Add(-1)}, Range{at, r.end}, true
+}
+
+// FreeGaps returns the ranges of dates within window that are not covered
+// by any of the busy ranges, in chronological order. The busy ranges may be
+// given in any order and may overlap, touch, or extend beyond the window.
+// FreeGaps returns nil if the busy ranges cover the whole window.
+func FreeGaps(window Range, busy []Range) []Range {
+	var gaps []Range
+	// The first date not yet known to be busy, kept in an int64 so that
+	// moving past Max() does not wrap around
+	next := int64(window.start.day)
+	for _, b := range MergeRanges(busy) {
+		if b.start.day > window.end.day {
+			break
+		}
+		if int64(b.start.day) > next {
+			gaps = append(gaps, Range{Date{int32(next)}, b.start.Add(-1)})
+		}
+		if int64(b.end.day)+1 > next {
+			next = int64(b.end.day) + 1
+		}
+	}
+	if next <= int64(window.end.day) {
+		gaps = append(gaps, Range{Date{int32(next)}, window.end})
+	}
+	return gaps
+}
+
+// MergeRanges returns the smallest set of ranges covering the same dates as
+// the given ranges, sorted in chronological order. Overlapping ranges are
+// merged, and so are adjacent ranges, where one range ends on the day before
+// the other starts (e.g. 2015-07-01/2015-07-10 and 2015-07-11/2015-07-20
+// merge into 2015-07-01/2015-07-20). The given slice is not modified.
+func MergeRanges(ranges []Range) []Range {
+	if len(ranges) == 0 {
+		return nil
+	}
+	sorted := make([]Range, len(ranges))
+	copy(sorted, ranges)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].start.day < sorted[j].start.day
+	})
+	merged := sorted[:1]
+	for _, r := range sorted[1:] {
+		last := &merged[len(merged)-1]
+		if int64(r.start.day) <= int64(last.end.day)+1 {
+			if r.end.After(last.end) {
+				last.end = r.end
+			}
+		} else {
+			merged = append(merged, r)
+		}
+	}
+	return merged
+}
+
+// String returns the range formatted as an ISO 8601 time interval
+// (e.g. "2006-01-02/2006-01-31").
+func (r Range) String() string {
+	return r.start.String() + "/" + r.end.String()
+}
@@ -0,0 +1,38 @@
+// Copyright 2015 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.23
+
+package date
+
+import "iter"
+
+// All returns an iterator over the dates in the range, in chronological
+// order. Unlike Dates, it does not allocate the dates up front, so it is
+// suitable for very long ranges:
+//
+//	for d := range r.All() {
+//	    ...
+//	}
+func (r Range) All() iter.Seq[Date] {
+	return func(yield func(Date) bool) {
+		for d := r.start; ; d = d.Add(1) {
+			if !yield(d) || d == r.end {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the dates in the range, in reverse
+// chronological order.
+func (r Range) Backward() iter.Seq[Date] {
+	return func(yield func(Date) bool) {
+		for d := r.end; ; d = d.Add(-1) {
+			if !yield(d) || d == r.start {
+				return
+			}
+		}
+	}
+}
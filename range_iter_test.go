@@ -0,0 +1,73 @@
+// Copyright 2015 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.23
+
+package date_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fxtlabs/date"
+)
+
+func TestRangeAll(t *testing.T) {
+	cases := []date.Range{
+		date.NewRange(date.New(2015, time.July, 11), date.New(2015, time.July, 11)),
+		date.NewRange(date.New(2015, time.December, 28), date.New(2016, time.January, 3)),
+		date.NewRange(date.Min(), date.Min().Add(2)),
+		date.NewRange(date.Max().Add(-2), date.Max()),
+	}
+	for _, c := range cases {
+		dates := c.Dates()
+		i := 0
+		for d := range c.All() {
+			if i >= len(dates) || d != dates[i] {
+				t.Errorf("All(%v)[%d] == %v", c, i, d)
+				break
+			}
+			i++
+		}
+		if i != len(dates) {
+			t.Errorf("All(%v) yielded %d dates, want %d", c, i, len(dates))
+		}
+
+		i = len(dates)
+		for d := range c.Backward() {
+			i--
+			if i < 0 || d != dates[i] {
+				t.Errorf("Backward(%v)[%d] == %v", c, i, d)
+				break
+			}
+		}
+		if i != 0 {
+			t.Errorf("Backward(%v) yielded %d dates, want %d", c, len(dates)-i, len(dates))
+		}
+	}
+}
+
+func TestRangeAllBreak(t *testing.T) {
+	r := date.NewRange(date.New(2015, time.July, 1), date.New(2015, time.July, 31))
+	n := 0
+	for d := range r.All() {
+		n++
+		if d == date.New(2015, time.July, 5) {
+			break
+		}
+	}
+	if n != 5 {
+		t.Errorf("All(%v) with break visited %d dates, want 5", r, n)
+	}
+	n = 0
+	for d := range r.Backward() {
+		n++
+		if d == date.New(2015, time.July, 27) {
+			break
+		}
+	}
+	if n != 5 {
+		t.Errorf("Backward(%v) with break visited %d dates, want 5", r, n)
+	}
+}
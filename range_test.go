@@ -0,0 +1,494 @@
+// Copyright 2015 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/fxtlabs/date"
+)
+
+func TestNewRange(t *testing.T) {
+	d1 := date.New(2015, time.July, 11)
+	d2 := date.New(2015, time.August, 2)
+	cases := []struct {
+		start, end date.Date
+		days       date.PeriodOfDays
+	}{
+		{d1, d2, 22},
+		{d2, d1, 22},
+		{d1, d1, 0},
+		{date.Min(), date.Min().Add(math.MaxInt32), math.MaxInt32},
+		{date.Max().Add(-math.MaxInt32), date.Max(), math.MaxInt32},
+	}
+	for _, c := range cases {
+		r := date.NewRange(c.start, c.end)
+		if r.Start().After(r.End()) {
+			t.Errorf("NewRange(%v, %v) == %v, want start before end", c.start, c.end, r)
+		}
+		if r.Days() != c.days {
+			t.Errorf("Days(%v) == %v, want %v", r, r.Days(), c.days)
+		}
+	}
+
+	// Ranges spanning more than MaxInt32 days cannot report their length
+	checkedCases := []struct {
+		value date.Range
+		days  date.PeriodOfDays
+		ok    bool
+	}{
+		{date.NewRange(d1, d2), 22, true},
+		{date.NewRange(date.Min(), date.Min().Add(math.MaxInt32)), math.MaxInt32, true},
+		{date.NewRange(date.Min(), date.Min().Add(math.MaxInt32).Add(1)), math.MaxInt32, false},
+		{date.NewRange(date.Max().Add(-math.MaxInt32).Add(-1), date.Max()), math.MaxInt32, false},
+		{date.NewRange(date.Min(), date.Max()), math.MaxInt32, false},
+	}
+	for _, c := range checkedCases {
+		days, ok := c.value.DaysChecked()
+		if ok != c.ok || (ok && days != c.days) {
+			t.Errorf("DaysChecked(%v) == %v, %v, want %v, %v", c.value, days, ok, c.days, c.ok)
+		}
+		if days := c.value.Days(); days != c.days {
+			t.Errorf("Days(%v) == %v, want %v", c.value, days, c.days)
+		}
+	}
+}
+
+func TestPeriodRanges(t *testing.T) {
+	cases := []struct {
+		name       string
+		value      date.Range
+		start, end date.Date
+		days       int
+	}{
+		{"YearRange(2015)", date.YearRange(2015), date.New(2015, time.January, 1), date.New(2015, time.December, 31), 365},
+		{"YearRange(2016)", date.YearRange(2016), date.New(2016, time.January, 1), date.New(2016, time.December, 31), 366},
+		{"YearRange(-1)", date.YearRange(-1), date.New(-1, time.January, 1), date.New(-1, time.December, 31), 365},
+		{"QuarterRange(2015, 1)", date.QuarterRange(2015, 1), date.New(2015, time.January, 1), date.New(2015, time.March, 31), 90},
+		{"QuarterRange(2016, 1)", date.QuarterRange(2016, 1), date.New(2016, time.January, 1), date.New(2016, time.March, 31), 91},
+		{"QuarterRange(2015, 2)", date.QuarterRange(2015, 2), date.New(2015, time.April, 1), date.New(2015, time.June, 30), 91},
+		{"QuarterRange(2015, 3)", date.QuarterRange(2015, 3), date.New(2015, time.July, 1), date.New(2015, time.September, 30), 92},
+		{"QuarterRange(2015, 4)", date.QuarterRange(2015, 4), date.New(2015, time.October, 1), date.New(2015, time.December, 31), 92},
+		{"MonthRange(2015, February)", date.MonthRange(2015, time.February), date.New(2015, time.February, 1), date.New(2015, time.February, 28), 28},
+		{"MonthRange(2016, February)", date.MonthRange(2016, time.February), date.New(2016, time.February, 1), date.New(2016, time.February, 29), 29},
+		{"MonthRange(2015, July)", date.MonthRange(2015, time.July), date.New(2015, time.July, 1), date.New(2015, time.July, 31), 31},
+		{"MonthRange(2015, December)", date.MonthRange(2015, time.December), date.New(2015, time.December, 1), date.New(2015, time.December, 31), 31},
+	}
+	for _, c := range cases {
+		if c.value.Start() != c.start || c.value.End() != c.end {
+			t.Errorf("%s == %v, want %v", c.name, c.value, date.NewRange(c.start, c.end))
+		}
+		if n := int(c.value.Days()) + 1; n != c.days {
+			t.Errorf("%s has %v days, want %v", c.name, n, c.days)
+		}
+	}
+
+	// Every month of a year must match DaysIn
+	for year := 2015; year <= 2016; year++ {
+		for month := time.January; month <= time.December; month++ {
+			r := date.MonthRange(year, month)
+			if n := int(r.Days()) + 1; n != date.DaysIn(year, month) {
+				t.Errorf("MonthRange(%v, %v) has %v days, want %v", year, month, n, date.DaysIn(year, month))
+			}
+		}
+	}
+
+	panics := []struct {
+		name string
+		f    func()
+	}{
+		{"QuarterRange(2015, 0)", func() { date.QuarterRange(2015, 0) }},
+		{"QuarterRange(2015, 5)", func() { date.QuarterRange(2015, 5) }},
+		{"MonthRange(2015, 0)", func() { date.MonthRange(2015, 0) }},
+		{"MonthRange(2015, 13)", func() { date.MonthRange(2015, 13) }},
+	}
+	for _, p := range panics {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s did not panic", p.name)
+				}
+			}()
+			p.f()
+		}()
+	}
+}
+
+func TestRangeContains(t *testing.T) {
+	r := date.NewRange(date.New(2015, time.July, 11), date.New(2015, time.July, 14))
+	cases := []struct {
+		value date.Date
+		want  bool
+	}{
+		{date.New(2015, time.July, 10), false},
+		{date.New(2015, time.July, 11), true},
+		{date.New(2015, time.July, 12), true},
+		{date.New(2015, time.July, 14), true},
+		{date.New(2015, time.July, 15), false},
+	}
+	for _, c := range cases {
+		if r.Contains(c.value) != c.want {
+			t.Errorf("Contains(%v, %v) == %v, want %v", r, c.value, !c.want, c.want)
+		}
+	}
+}
+
+func TestRangeOverlaps(t *testing.T) {
+	d := date.New(2015, time.July, 11)
+	r := date.NewRange(d, d.Add(3))
+	cases := []struct {
+		value date.Range
+		want  bool
+	}{
+		{date.NewRange(d.Add(-5), d.Add(-1)), false},
+		{date.NewRange(d.Add(-5), d), true},
+		{date.NewRange(d.Add(1), d.Add(2)), true},
+		{date.NewRange(d.Add(-1), d.Add(4)), true},
+		{date.NewRange(d.Add(3), d.Add(9)), true},
+		{date.NewRange(d.Add(4), d.Add(9)), false},
+	}
+	for _, c := range cases {
+		if r.Overlaps(c.value) != c.want {
+			t.Errorf("Overlaps(%v, %v) == %v, want %v", r, c.value, !c.want, c.want)
+		}
+		if c.value.Overlaps(r) != c.want {
+			t.Errorf("Overlaps(%v, %v) == %v, want %v", c.value, r, !c.want, c.want)
+		}
+	}
+}
+
+func TestRangeEach(t *testing.T) {
+	cases := []date.Range{
+		date.NewRange(date.New(2015, time.July, 11), date.New(2015, time.July, 11)),
+		date.NewRange(date.New(2015, time.December, 28), date.New(2016, time.January, 3)),
+		date.NewRange(date.Max().Add(-2), date.Max()),
+	}
+	for _, c := range cases {
+		var dates []date.Date
+		c.Each(func(d date.Date) {
+			dates = append(dates, d)
+		})
+		if len(dates) != int(c.Days())+1 {
+			t.Errorf("Each(%v) visited %d dates, want %d", c, len(dates), c.Days()+1)
+			continue
+		}
+		for i, d := range dates {
+			if d != c.Start().Add(i) {
+				t.Errorf("Each(%v)[%d] == %v, want %v", c, i, d, c.Start().Add(i))
+			}
+		}
+	}
+}
+
+func TestRangeDates(t *testing.T) {
+	cases := []date.Range{
+		date.NewRange(date.New(2015, time.July, 11), date.New(2015, time.July, 11)),
+		date.NewRange(date.New(2016, time.February, 27), date.New(2016, time.March, 2)),
+		date.NewRange(date.New(2015, time.December, 1), date.New(2016, time.January, 31)),
+	}
+	for _, c := range cases {
+		dates := c.Dates()
+		if len(dates) != int(c.Days())+1 {
+			t.Errorf("Dates(%v) has %d dates, want %d", c, len(dates), c.Days()+1)
+			continue
+		}
+		if dates[0] != c.Start() || dates[len(dates)-1] != c.End() {
+			t.Errorf("Dates(%v) == [%v ... %v]", c, dates[0], dates[len(dates)-1])
+		}
+		for i := 1; i < len(dates); i++ {
+			if dates[i].Sub(dates[i-1]) != 1 {
+				t.Errorf("Dates(%v)[%d] == %v, want %v", c, i, dates[i], dates[i-1].Add(1))
+			}
+		}
+	}
+}
+
+func TestRangeStarts(t *testing.T) {
+	cases := []struct {
+		value date.Range
+		name  string
+		f     func(date.Range) []date.Date
+		want  []date.Date
+	}{
+		{
+			date.NewRange(date.New(2015, time.July, 11), date.New(2015, time.July, 14)),
+			"MonthStarts", date.Range.MonthStarts,
+			[]date.Date{date.New(2015, time.July, 1)},
+		},
+		{
+			date.NewRange(date.New(2015, time.November, 15), date.New(2016, time.February, 1)),
+			"MonthStarts", date.Range.MonthStarts,
+			[]date.Date{date.New(2015, time.November, 1), date.New(2015, time.December, 1), date.New(2016, time.January, 1), date.New(2016, time.February, 1)},
+		},
+		{
+			date.NewRange(date.New(2015, time.January, 31), date.New(2015, time.March, 31)),
+			"MonthStarts", date.Range.MonthStarts,
+			[]date.Date{date.New(2015, time.January, 1), date.New(2015, time.February, 1), date.New(2015, time.March, 1)},
+		},
+		{
+			date.NewRange(date.New(2015, time.August, 20), date.New(2016, time.April, 1)),
+			"QuarterStarts", date.Range.QuarterStarts,
+			[]date.Date{date.New(2015, time.July, 1), date.New(2015, time.October, 1), date.New(2016, time.January, 1), date.New(2016, time.April, 1)},
+		},
+		{
+			date.NewRange(date.New(2015, time.December, 31), date.New(2015, time.December, 31)),
+			"QuarterStarts", date.Range.QuarterStarts,
+			[]date.Date{date.New(2015, time.October, 1)},
+		},
+		{
+			date.NewRange(date.New(2014, time.July, 11), date.New(2016, time.January, 1)),
+			"YearStarts", date.Range.YearStarts,
+			[]date.Date{date.New(2014, time.January, 1), date.New(2015, time.January, 1), date.New(2016, time.January, 1)},
+		},
+		// The first unit starts before Min() and cannot be represented
+		{
+			date.NewRange(date.Min(), date.Min().Add(100)),
+			"MonthStarts", date.Range.MonthStarts,
+			[]date.Date{date.New(-5877641, time.July, 1), date.New(-5877641, time.August, 1), date.New(-5877641, time.September, 1), date.New(-5877641, time.October, 1)},
+		},
+		{
+			date.NewRange(date.Min(), date.Min().Add(100)),
+			"QuarterStarts", date.Range.QuarterStarts,
+			[]date.Date{date.New(-5877641, time.July, 1), date.New(-5877641, time.October, 1)},
+		},
+		{
+			date.NewRange(date.Min(), date.Min().Add(100)),
+			"YearStarts", date.Range.YearStarts,
+			nil,
+		},
+		{
+			date.NewRange(date.Min(), date.Min().Add(200)),
+			"YearStarts", date.Range.YearStarts,
+			[]date.Date{date.New(-5877640, time.January, 1)},
+		},
+		{
+			date.NewRange(date.Max().Add(-40), date.Max()),
+			"MonthStarts", date.Range.MonthStarts,
+			[]date.Date{date.New(5881580, time.June, 1), date.New(5881580, time.July, 1)},
+		},
+		{
+			date.NewRange(date.Max().Add(-40), date.Max()),
+			"YearStarts", date.Range.YearStarts,
+			[]date.Date{date.New(5881580, time.January, 1)},
+		},
+	}
+	for _, c := range cases {
+		if dates := c.f(c.value); !sameDates(dates, c.want) {
+			t.Errorf("%s(%v) == %v, want %v", c.name, c.value, dates, c.want)
+		}
+	}
+}
+
+func TestRangeWeekendDays(t *testing.T) {
+	cases := []struct {
+		value             date.Range
+		weekend, weekdays int
+	}{
+		// July 11, 2015 was a Saturday
+		{date.NewRange(date.New(2015, time.July, 11), date.New(2015, time.July, 11)), 1, 0},
+		{date.NewRange(date.New(2015, time.July, 11), date.New(2015, time.July, 12)), 2, 0},
+		{date.NewRange(date.New(2015, time.July, 13), date.New(2015, time.July, 17)), 0, 5},
+		{date.NewRange(date.New(2015, time.July, 1), date.New(2015, time.July, 31)), 8, 23},
+		{date.NewRange(date.New(2015, time.January, 1), date.New(2015, time.December, 31)), 104, 261},
+		{date.NewRange(date.Min(), date.Max()), 1227133512, 3067833784},
+	}
+	for _, c := range cases {
+		if n := c.value.WeekendDays(); n != c.weekend {
+			t.Errorf("WeekendDays(%v) == %v, want %v", c.value, n, c.weekend)
+		}
+		if n := c.value.Weekdays(); n != c.weekdays {
+			t.Errorf("Weekdays(%v) == %v, want %v", c.value, n, c.weekdays)
+		}
+	}
+
+	// Compare against counting the days one by one
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		start := date.New(2015, time.July, 11).Add(rnd.Intn(4000) - 2000)
+		r := date.NewRange(start, start.Add(rnd.Intn(100)))
+		var weekend, weekdays int
+		r.Each(func(d date.Date) {
+			if d.IsWeekend() {
+				weekend++
+			} else {
+				weekdays++
+			}
+		})
+		if n := r.WeekendDays(); n != weekend {
+			t.Errorf("WeekendDays(%v) == %v, want %v", r, n, weekend)
+		}
+		if n := r.Weekdays(); n != weekdays {
+			t.Errorf("Weekdays(%v) == %v, want %v", r, n, weekdays)
+		}
+	}
+}
+
+func TestFreeGaps(t *testing.T) {
+	day := func(n int) date.Date {
+		return date.New(2015, time.July, n)
+	}
+	window := date.NewRange(day(1), day(31))
+	cases := []struct {
+		busy []date.Range
+		want []date.Range
+	}{
+		// No busy ranges
+		{nil, []date.Range{window}},
+		// A single busy range inside the window
+		{
+			[]date.Range{date.NewRange(day(10), day(12))},
+			[]date.Range{date.NewRange(day(1), day(9)), date.NewRange(day(13), day(31))},
+		},
+		// Busy ranges at both ends of the window
+		{
+			[]date.Range{date.NewRange(day(1), day(5)), date.NewRange(day(28), day(31))},
+			[]date.Range{date.NewRange(day(6), day(27))},
+		},
+		// Busy ranges extending beyond the window are clipped
+		{
+			[]date.Range{date.NewRange(day(25), date.New(2015, time.August, 9)), date.NewRange(date.New(2015, time.June, 1), day(3))},
+			[]date.Range{date.NewRange(day(4), day(24))},
+		},
+		// Busy ranges entirely outside the window are ignored
+		{
+			[]date.Range{date.NewRange(date.New(2015, time.June, 1), date.New(2015, time.June, 30)), date.NewRange(date.New(2015, time.August, 1), date.New(2015, time.August, 1))},
+			[]date.Range{window},
+		},
+		// Touching, overlapping and nested busy ranges, out of order
+		{
+			[]date.Range{
+				date.NewRange(day(20), day(22)),
+				date.NewRange(day(10), day(15)),
+				date.NewRange(day(11), day(12)),
+				date.NewRange(day(16), day(17)),
+				date.NewRange(day(14), day(16)),
+				date.NewRange(day(23), day(23)),
+			},
+			[]date.Range{date.NewRange(day(1), day(9)), date.NewRange(day(18), day(19)), date.NewRange(day(24), day(31))},
+		},
+		// Single free days between busy ranges
+		{
+			[]date.Range{date.NewRange(day(1), day(9)), date.NewRange(day(11), day(30))},
+			[]date.Range{date.NewRange(day(10), day(10)), date.NewRange(day(31), day(31))},
+		},
+		// The whole window is busy
+		{
+			[]date.Range{date.NewRange(day(1), day(15)), date.NewRange(day(16), day(31))},
+			nil,
+		},
+		{
+			[]date.Range{date.NewRange(date.New(2015, time.January, 1), date.New(2015, time.December, 31))},
+			nil,
+		},
+	}
+	for _, c := range cases {
+		gaps := date.FreeGaps(window, c.busy)
+		if !sameRanges(gaps, c.want) {
+			t.Errorf("FreeGaps(%v, %v) == %v, want %v", window, c.busy, gaps, c.want)
+		}
+	}
+
+	// A window ending at Max must not wrap around
+	end := date.NewRange(date.Max().Add(-9), date.Max())
+	busy := []date.Range{date.NewRange(date.Max().Add(-5), date.Max())}
+	want := []date.Range{date.NewRange(date.Max().Add(-9), date.Max().Add(-6))}
+	if gaps := date.FreeGaps(end, busy); !sameRanges(gaps, want) {
+		t.Errorf("FreeGaps(%v, %v) == %v, want %v", end, busy, gaps, want)
+	}
+}
+
+func TestMergeRanges(t *testing.T) {
+	day := func(n int) date.Date {
+		return date.New(2015, time.July, n)
+	}
+	cases := []struct {
+		value []date.Range
+		want  []date.Range
+	}{
+		{nil, nil},
+		{[]date.Range{date.NewRange(day(1), day(5))}, []date.Range{date.NewRange(day(1), day(5))}},
+		// Separated by a single day
+		{
+			[]date.Range{date.NewRange(day(7), day(9)), date.NewRange(day(1), day(5))},
+			[]date.Range{date.NewRange(day(1), day(5)), date.NewRange(day(7), day(9))},
+		},
+		// Adjacent
+		{
+			[]date.Range{date.NewRange(day(6), day(9)), date.NewRange(day(1), day(5))},
+			[]date.Range{date.NewRange(day(1), day(9))},
+		},
+		// Sharing a day
+		{
+			[]date.Range{date.NewRange(day(1), day(5)), date.NewRange(day(5), day(9))},
+			[]date.Range{date.NewRange(day(1), day(9))},
+		},
+		// Nested and duplicated
+		{
+			[]date.Range{date.NewRange(day(1), day(20)), date.NewRange(day(3), day(4)), date.NewRange(day(1), day(20)), date.NewRange(day(20), day(20))},
+			[]date.Range{date.NewRange(day(1), day(20))},
+		},
+		// Chains of overlapping ranges
+		{
+			[]date.Range{date.NewRange(day(10), day(14)), date.NewRange(day(1), day(3)), date.NewRange(day(13), day(18)), date.NewRange(day(4), day(6)), date.NewRange(day(25), day(31))},
+			[]date.Range{date.NewRange(day(1), day(6)), date.NewRange(day(10), day(18)), date.NewRange(day(25), day(31))},
+		},
+		// Extreme dates
+		{
+			[]date.Range{date.NewRange(date.Max().Add(-3), date.Max()), date.NewRange(date.Min(), date.Min()), date.NewRange(date.Max().Add(-9), date.Max().Add(-4))},
+			[]date.Range{date.NewRange(date.Min(), date.Min()), date.NewRange(date.Max().Add(-9), date.Max())},
+		},
+	}
+	for _, c := range cases {
+		value := append([]date.Range(nil), c.value...)
+		if merged := date.MergeRanges(c.value); !sameRanges(merged, c.want) {
+			t.Errorf("MergeRanges(%v) == %v, want %v", c.value, merged, c.want)
+		}
+		if !sameRanges(c.value, value) {
+			t.Errorf("MergeRanges modified its argument to %v, want %v", c.value, value)
+		}
+	}
+}
+
+func TestRangeSplit(t *testing.T) {
+	r := date.NewRange(date.New(2015, time.July, 11), date.New(2015, time.July, 14))
+	cases := []struct {
+		at            date.Date
+		first, second date.Range
+		ok            bool
+	}{
+		{date.New(2015, time.July, 10), r, date.Range{}, false},
+		{date.New(2015, time.July, 11), r, date.Range{}, false},
+		{date.New(2015, time.July, 12), date.NewRange(date.New(2015, time.July, 11), date.New(2015, time.July, 11)), date.NewRange(date.New(2015, time.July, 12), date.New(2015, time.July, 14)), true},
+		{date.New(2015, time.July, 13), date.NewRange(date.New(2015, time.July, 11), date.New(2015, time.July, 12)), date.NewRange(date.New(2015, time.July, 13), date.New(2015, time.July, 14)), true},
+		{date.New(2015, time.July, 14), date.NewRange(date.New(2015, time.July, 11), date.New(2015, time.July, 13)), date.NewRange(date.New(2015, time.July, 14), date.New(2015, time.July, 14)), true},
+		{date.New(2015, time.July, 15), r, date.Range{}, false},
+	}
+	for _, c := range cases {
+		first, second, ok := r.Split(c.at)
+		if first != c.first || second != c.second || ok != c.ok {
+			t.Errorf("Split(%v, %v) == (%v, %v, %v), want (%v, %v, %v)", r, c.at, first, second, ok, c.first, c.second, c.ok)
+		}
+		if ok {
+			if merged := date.MergeRanges([]date.Range{second, first}); !sameRanges(merged, []date.Range{r}) {
+				t.Errorf("MergeRanges(Split(%v, %v)) == %v, want %v", r, c.at, merged, r)
+			}
+		}
+	}
+}
+
+func sameRanges(a, b []date.Range) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -5,6 +5,7 @@
 package date
 
 import (
+	"math"
 	"math/rand"
 	"testing"
 	"time"
@@ -122,3 +123,19 @@ func TestZone(t *testing.T) {
 		}
 	}
 }
+
+// TestWeekday checks the weekday arithmetic against the weekday of the
+// decoded time.Time value around day zero and at the extreme representable
+// dates, where the remainder is negative or the offset could overflow.
+func TestWeekday(t *testing.T) {
+	var cases []int32
+	for i := int32(0); i < 15; i++ {
+		cases = append(cases, math.MinInt32+i, i-7, math.MaxInt32-i)
+	}
+	for _, c := range cases {
+		d := Date{c}
+		if w, want := d.Weekday(), decode(c).Weekday(); w != want {
+			t.Errorf("Weekday(%v) == %v, want %v", c, w, want)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright 2015 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+import "sort"
+
+// A Set is a set of dates. It is kept as a sorted slice of distinct dates,
+// so membership queries take logarithmic time and dates can be visited in
+// chronological order; adding or removing a date takes linear time.
+//
+// The zero value of type Set is an empty set ready to use.
+// Copying a Set value does not copy its dates, so sets should be passed
+// by pointer when they are meant to be modified.
+type Set struct {
+	dates []Date
+}
+
+// NewSet returns a Set holding the given dates.
+func NewSet(dates ...Date) Set {
+	s := make([]Date, len(dates))
+	copy(s, dates)
+	Sort(s)
+	return Set{Dedup(s)}
+}
+
+// search returns the index of d in s or, if s does not contain d, the
+// index at which d should be inserted.
+func (s Set) search(d Date) int {
+	return sort.Search(len(s.dates), func(i int) bool {
+		return !s.dates[i].Before(d)
+	})
+}
+
+// Len returns the number of dates in s.
+func (s Set) Len() int {
+	return len(s.dates)
+}
+
+// Contains reports whether d is in s.
+func (s Set) Contains(d Date) bool {
+	i := s.search(d)
+	return i < len(s.dates) && s.dates[i] == d
+}
+
+// Add adds d to s. It has no effect if d is already in s.
+func (s *Set) Add(d Date) {
+	i := s.search(d)
+	if i < len(s.dates) && s.dates[i] == d {
+		return
+	}
+	s.dates = append(s.dates, Date{})
+	copy(s.dates[i+1:], s.dates[i:])
+	s.dates[i] = d
+}
+
+// Remove removes d from s. It has no effect if d is not in s.
+func (s *Set) Remove(d Date) {
+	i := s.search(d)
+	if i < len(s.dates) && s.dates[i] == d {
+		s.dates = append(s.dates[:i], s.dates[i+1:]...)
+	}
+}
+
+// Union returns a new Set holding the dates that are in s, in u, or in both.
+func (s Set) Union(u Set) Set {
+	dates := make([]Date, 0, len(s.dates)+len(u.dates))
+	i, j := 0, 0
+	for i < len(s.dates) && j < len(u.dates) {
+		switch d, e := s.dates[i], u.dates[j]; {
+		case d.Before(e):
+			dates = append(dates, d)
+			i++
+		case e.Before(d):
+			dates = append(dates, e)
+			j++
+		default:
+			dates = append(dates, d)
+			i++
+			j++
+		}
+	}
+	dates = append(dates, s.dates[i:]...)
+	dates = append(dates, u.dates[j:]...)
+	return Set{dates}
+}
+
+// Intersect returns a new Set holding the dates that are both in s and in u.
+func (s Set) Intersect(u Set) Set {
+	var dates []Date
+	i, j := 0, 0
+	for i < len(s.dates) && j < len(u.dates) {
+		switch d, e := s.dates[i], u.dates[j]; {
+		case d.Before(e):
+			i++
+		case e.Before(d):
+			j++
+		default:
+			dates = append(dates, d)
+			i++
+			j++
+		}
+	}
+	return Set{dates}
+}
+
+// Dates returns the dates in s in chronological order.
+func (s Set) Dates() []Date {
+	dates := make([]Date, len(s.dates))
+	copy(dates, s.dates)
+	return dates
+}
+
+// Each calls f for each date in s, in chronological order.
+func (s Set) Each(f func(d Date)) {
+	for _, d := range s.dates {
+		f(d)
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright 2015 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fxtlabs/date"
+)
+
+func sameDates(a, b []date.Date) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestSet(t *testing.T) {
+	d := date.New(2015, time.July, 11)
+	var s date.Set
+	if s.Len() != 0 || s.Contains(d) {
+		t.Errorf("zero Set == %v, want empty", s.Dates())
+	}
+	s.Add(d)
+	s.Add(d.Add(-3))
+	s.Add(d.Add(5))
+	s.Add(d)
+	s.Add(date.Min())
+	want := []date.Date{date.Min(), d.Add(-3), d, d.Add(5)}
+	if !sameDates(s.Dates(), want) {
+		t.Errorf("Set.Add(...) == %v, want %v", s.Dates(), want)
+	}
+	for _, u := range want {
+		if !s.Contains(u) {
+			t.Errorf("Contains(%v) == false, want true", u)
+		}
+	}
+	if s.Contains(d.Add(1)) || s.Contains(date.Max()) {
+		t.Errorf("Contains(...) == true, want false")
+	}
+
+	s.Remove(d.Add(1))
+	s.Remove(d)
+	s.Remove(date.Min())
+	want = []date.Date{d.Add(-3), d.Add(5)}
+	if !sameDates(s.Dates(), want) || s.Len() != 2 {
+		t.Errorf("Set.Remove(...) == %v, want %v", s.Dates(), want)
+	}
+
+	var visited []date.Date
+	s.Each(func(u date.Date) {
+		visited = append(visited, u)
+	})
+	if !sameDates(visited, want) {
+		t.Errorf("Set.Each(...) visited %v, want %v", visited, want)
+	}
+}
+
+func TestSetAlgebra(t *testing.T) {
+	july := date.NewRange(date.New(2015, time.July, 1), date.New(2015, time.July, 31))
+	summer := date.NewRange(date.New(2015, time.June, 21), date.New(2015, time.July, 10))
+	a := date.NewSet(july.Dates()...)
+	b := date.NewSet(append(summer.Dates(), summer.Dates()...)...)
+	if a.Len() != 31 || b.Len() != 20 {
+		t.Errorf("NewSet(...).Len() == %v, %v, want 31, 20", a.Len(), b.Len())
+	}
+
+	union := a.Union(b)
+	if want := date.NewRange(summer.Start(), july.End()).Dates(); !sameDates(union.Dates(), want) {
+		t.Errorf("Union(%v, %v) == %v, want %v", july, summer, union.Dates(), want)
+	}
+	if !sameDates(b.Union(a).Dates(), union.Dates()) {
+		t.Errorf("Union(%v, %v) != Union(%v, %v)", summer, july, july, summer)
+	}
+
+	intersection := a.Intersect(b)
+	if want := date.NewRange(july.Start(), summer.End()).Dates(); !sameDates(intersection.Dates(), want) {
+		t.Errorf("Intersect(%v, %v) == %v, want %v", july, summer, intersection.Dates(), want)
+	}
+	if !sameDates(b.Intersect(a).Dates(), intersection.Dates()) {
+		t.Errorf("Intersect(%v, %v) != Intersect(%v, %v)", summer, july, july, summer)
+	}
+
+	var empty date.Set
+	if a.Intersect(empty).Len() != 0 || a.Union(empty).Len() != a.Len() {
+		t.Errorf("set algebra with an empty set failed")
+	}
+}
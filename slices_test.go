@@ -0,0 +1,34 @@
+// Copyright 2015 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.21
+
+package date_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/fxtlabs/date"
+)
+
+func TestSlicesSortFunc(t *testing.T) {
+	d := date.New(2015, time.July, 11)
+	dates := []date.Date{d.Add(3), date.Max(), d, date.Min(), d.Add(-3), d}
+	slices.SortFunc(dates, date.Date.Compare)
+	want := []date.Date{date.Min(), d.Add(-3), d, d, d.Add(3), date.Max()}
+	if !sameDates(dates, want) {
+		t.Errorf("SortFunc(...) == %v, want %v", dates, want)
+	}
+	if !date.IsSorted(dates) {
+		t.Errorf("IsSorted(%v) == false, want true", dates)
+	}
+	if i, found := slices.BinarySearchFunc(dates, d.Add(3), date.Date.Compare); !found || i != 4 {
+		t.Errorf("BinarySearchFunc(%v, %v) == %v, %v, want 4, true", dates, d.Add(3), i, found)
+	}
+	if dates = date.Dedup(dates); !sameDates(dates, slices.Compact(append([]date.Date(nil), want...))) {
+		t.Errorf("Dedup(...) == %v, want %v", dates, slices.Compact(want))
+	}
+}
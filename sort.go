@@ -0,0 +1,48 @@
+// Copyright 2015 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+import "sort"
+
+// byDay implements sort.Interface for a slice of dates in ascending order.
+type byDay []Date
+
+func (s byDay) Len() int           { return len(s) }
+func (s byDay) Less(i, j int) bool { return s[i].day < s[j].day }
+func (s byDay) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// Sort sorts a slice of dates in ascending order.
+func Sort(dates []Date) {
+	sort.Sort(byDay(dates))
+}
+
+// SortStable sorts a slice of dates in ascending order. Since equal dates
+// are indistinguishable, the result is the same as that of Sort; it is
+// provided for symmetry with package sort.
+func SortStable(dates []Date) {
+	sort.Stable(byDay(dates))
+}
+
+// IsSorted reports whether a slice of dates is sorted in ascending order.
+func IsSorted(dates []Date) bool {
+	return sort.IsSorted(byDay(dates))
+}
+
+// Dedup removes consecutive duplicate dates from a slice, so that a sorted
+// slice ends up holding each date once. Like slices.Compact, it works in
+// place and returns the shortened slice.
+func Dedup(dates []Date) []Date {
+	if len(dates) == 0 {
+		return dates
+	}
+	n := 1
+	for _, d := range dates[1:] {
+		if d != dates[n-1] {
+			dates[n] = d
+			n++
+		}
+	}
+	return dates[:n]
+}
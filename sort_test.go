@@ -0,0 +1,106 @@
+// Copyright 2015 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fxtlabs/date"
+)
+
+func TestSort(t *testing.T) {
+	want := []date.Date{
+		date.Min(),
+		date.New(-11111, time.February, 3),
+		date.New(-1, time.December, 31),
+		date.New(-1, time.December, 31),
+		date.New(0, time.January, 1),
+		date.New(1970, time.January, 1),
+		date.New(2015, time.July, 11),
+		date.New(2015, time.July, 11),
+		date.New(2015, time.July, 11),
+		date.New(12345, time.June, 7),
+		date.Max(),
+	}
+	if !date.IsSorted(want) {
+		t.Errorf("IsSorted(%v) == false, want true", want)
+	}
+	for _, sortFunc := range []func([]date.Date){date.Sort, date.SortStable} {
+		dates := []date.Date{
+			want[6], want[4], want[10], want[2], want[7], want[0],
+			want[9], want[3], want[1], want[8], want[5],
+		}
+		if date.IsSorted(dates) {
+			t.Errorf("IsSorted(%v) == true, want false", dates)
+		}
+		sortFunc(dates)
+		for i := range want {
+			if dates[i] != want[i] {
+				t.Errorf("Sort(...)[%d] == %v, want %v", i, dates[i], want[i])
+			}
+		}
+	}
+
+	if !date.IsSorted(nil) {
+		t.Errorf("IsSorted(nil) == false, want true")
+	}
+}
+
+func TestDedup(t *testing.T) {
+	d := date.New(2015, time.July, 11)
+	cases := []struct {
+		dates []date.Date
+		want  []date.Date
+	}{
+		{nil, nil},
+		{[]date.Date{d}, []date.Date{d}},
+		{[]date.Date{d, d, d}, []date.Date{d}},
+		{[]date.Date{d, d.Add(1), d.Add(1), d.Add(2)}, []date.Date{d, d.Add(1), d.Add(2)}},
+		{[]date.Date{date.Min(), date.Min(), d, d, date.Max(), date.Max()}, []date.Date{date.Min(), d, date.Max()}},
+		{[]date.Date{d, d.Add(1), d}, []date.Date{d, d.Add(1), d}},
+	}
+	for _, c := range cases {
+		dates := date.Dedup(append([]date.Date(nil), c.dates...))
+		if !sameDates(dates, c.want) {
+			t.Errorf("Dedup(%v) == %v, want %v", c.dates, dates, c.want)
+		}
+	}
+}
+
+// benchmarkDates returns a sorted slice of dates with many duplicates.
+func benchmarkDates() []date.Date {
+	dates := make([]date.Date, 10000)
+	d := date.New(2015, time.January, 1)
+	for i := range dates {
+		dates[i] = d.Add(i / 7)
+	}
+	return dates
+}
+
+func BenchmarkDedup(b *testing.B) {
+	dates := benchmarkDates()
+	work := make([]date.Date, len(dates))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(work, dates)
+		date.Dedup(work)
+	}
+}
+
+func BenchmarkDedupMap(b *testing.B) {
+	dates := benchmarkDates()
+	for i := 0; i < b.N; i++ {
+		seen := make(map[date.Date]bool)
+		var result []date.Date
+		for _, d := range dates {
+			if !seen[d] {
+				seen[d] = true
+				result = append(result, d)
+			}
+		}
+		date.Sort(result)
+	}
+}
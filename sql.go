@@ -0,0 +1,113 @@
+// Copyright 2015 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"time"
+)
+
+// Scan implements the sql.Scanner interface.
+// It accepts time.Time values as well as strings and byte slices in any
+// format accepted by ParseISO. The date of a time.Time value is taken
+// relative to its own location, so a driver returning midnight in a
+// non-UTC location does not shift the calendar day.
+// A NULL value (nil) sets the date to the zero date.
+func (d *Date) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		d.day = 0
+	case time.Time:
+		d.day = encode(v)
+	case []byte:
+		return d.UnmarshalText(v)
+	case string:
+		return d.UnmarshalText([]byte(v))
+	default:
+		return fmt.Errorf("Date.Scan: cannot scan %T (%v)", src, src)
+	}
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+// The date is given as a time.Time value at midnight UTC.
+func (d Date) Value() (driver.Value, error) {
+	return d.UTC(), nil
+}
+
+// NullDate represents a Date that may be null. It implements the sql.Scanner
+// and driver.Valuer interfaces so it can be used as a scan destination and
+// as a query argument, similar to sql.NullTime; unlike a plain Date, a NULL
+// value is not confused with the zero date (January 1, 1970).
+type NullDate struct {
+	Date  Date
+	Valid bool // Valid is true if Date is not NULL
+}
+
+// Scan implements the sql.Scanner interface.
+func (n *NullDate) Scan(src interface{}) error {
+	if src == nil {
+		n.Date, n.Valid = Date{}, false
+		return nil
+	}
+	n.Valid = true
+	return n.Date.Scan(src)
+}
+
+// Value implements the driver.Valuer interface.
+func (n NullDate) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Date.Value()
+}
+
+// Scan implements the sql.Scanner interface.
+// It accepts int64 values giving the days elapsed since January 1, 1970,
+// as stored in INTEGER columns; the value must be within the range of int32.
+// Any other value is scanned as by Date.Scan.
+func (e *EpochDate) Scan(src interface{}) error {
+	v, ok := src.(int64)
+	if !ok {
+		return (*Date)(e).Scan(src)
+	}
+	if v < math.MinInt32 || v > math.MaxInt32 {
+		return fmt.Errorf("EpochDate.Scan: epoch days out of range (%d)", v)
+	}
+	e.day = int32(v)
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+// The date is given as an int64 value counting the days elapsed since
+// January 1, 1970.
+func (e EpochDate) Value() (driver.Value, error) {
+	return int64(e.day), nil
+}
+
+// MarshalCSV returns the date in ISO 8601 extended format, as
+// Date.MarshalCSV does, or an empty string if the date is null.
+func (n NullDate) MarshalCSV() (string, error) {
+	if !n.Valid {
+		return "", nil
+	}
+	return n.Date.MarshalCSV()
+}
+
+// UnmarshalCSV sets the date from a CSV field in any format accepted by
+// ParseISO. Unlike Date.UnmarshalCSV, an empty field sets the date to null.
+func (n *NullDate) UnmarshalCSV(value string) error {
+	if value == "" {
+		n.Date, n.Valid = Date{}, false
+		return nil
+	}
+	if err := n.Date.UnmarshalCSV(value); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
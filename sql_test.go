@@ -0,0 +1,219 @@
+// Copyright 2015 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/fxtlabs/date"
+)
+
+// echoDriver is a minimal database/sql driver whose queries return the
+// arguments they are given as a single row.
+type echoDriver struct{}
+
+type echoConn struct{}
+
+type echoStmt struct{}
+
+type echoRows struct {
+	values []driver.Value
+	done   bool
+}
+
+func (echoDriver) Open(name string) (driver.Conn, error) { return echoConn{}, nil }
+
+func (echoConn) Prepare(query string) (driver.Stmt, error) { return echoStmt{}, nil }
+func (echoConn) Close() error                              { return nil }
+func (echoConn) Begin() (driver.Tx, error)                 { return nil, errors.New("not supported") }
+
+func (echoStmt) Close() error  { return nil }
+func (echoStmt) NumInput() int { return -1 }
+func (echoStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return nil, errors.New("not supported")
+}
+func (echoStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return &echoRows{values: args}, nil
+}
+
+func (r *echoRows) Columns() []string {
+	columns := make([]string, len(r.values))
+	for i := range columns {
+		columns[i] = "c"
+	}
+	return columns
+}
+func (r *echoRows) Close() error { return nil }
+func (r *echoRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	r.done = true
+	copy(dest, r.values)
+	return nil
+}
+
+func init() {
+	sql.Register("date-echo", echoDriver{})
+}
+
+func TestSQLRoundTrip(t *testing.T) {
+	db, err := sql.Open("date-echo", "")
+	if err != nil {
+		t.Fatalf("sql.Open error %v", err)
+	}
+	defer db.Close()
+
+	cases := []date.Date{
+		date.New(-11111, time.February, 3),
+		date.New(0, time.January, 1),
+		date.New(1970, time.January, 1),
+		date.New(2015, time.July, 11),
+		date.New(12345, time.June, 7),
+	}
+	for _, c := range cases {
+		var d date.Date
+		err := db.QueryRow("SELECT ?", c).Scan(&d)
+		if err != nil {
+			t.Errorf("SQL(%v) error %v", c, err)
+		} else if d != c {
+			t.Errorf("SQL(%v) == %v, want %v", c, d, c)
+		}
+	}
+
+	d := date.Today()
+	err = db.QueryRow("SELECT ?", nil).Scan(&d)
+	if err != nil {
+		t.Errorf("SQL(NULL) error %v", err)
+	} else if !d.IsZero() {
+		t.Errorf("SQL(NULL) == %v, want zero date", d)
+	}
+}
+
+func TestSQLNullDate(t *testing.T) {
+	db, err := sql.Open("date-echo", "")
+	if err != nil {
+		t.Fatalf("sql.Open error %v", err)
+	}
+	defer db.Close()
+
+	cases := []date.NullDate{
+		{},
+		{Date: date.Date{}, Valid: true},
+		{Date: date.New(2015, time.July, 11), Valid: true},
+	}
+	for _, c := range cases {
+		n := date.NullDate{Date: date.Today(), Valid: !c.Valid}
+		err := db.QueryRow("SELECT ?", c).Scan(&n)
+		if err != nil {
+			t.Errorf("SQL(%v) error %v", c, err)
+		} else if n != c {
+			t.Errorf("SQL(%v) == %v, want %v", c, n, c)
+		}
+	}
+
+	var n date.NullDate
+	if v, err := n.Value(); v != nil || err != nil {
+		t.Errorf("Value(%v) == %v (%v), want nil", n, v, err)
+	}
+	if err := n.Scan("not-a-date"); err == nil {
+		t.Errorf("Scan(not-a-date) == %v, want error", n)
+	}
+}
+
+func TestScan(t *testing.T) {
+	want := date.New(2015, time.July, 11)
+	cases := []interface{}{
+		time.Date(2015, time.July, 11, 0, 0, 0, 0, time.UTC),
+		time.Date(2015, time.July, 11, 0, 0, 0, 0, time.FixedZone("zone", -8*60*60)),
+		time.Date(2015, time.July, 11, 0, 0, 0, 0, time.FixedZone("zone", 10*60*60)),
+		"2015-07-11",
+		[]byte("2015-07-11"),
+	}
+	for _, c := range cases {
+		var d date.Date
+		err := d.Scan(c)
+		if err != nil {
+			t.Errorf("Scan(%v) error %v", c, err)
+		} else if d != want {
+			t.Errorf("Scan(%v) == %v, want %v", c, d, want)
+		}
+	}
+
+	badCases := []interface{}{
+		"not-a-date",
+		[]byte("2015-07-11T00:00:00Z"),
+		int64(42),
+		3.14,
+	}
+	for _, c := range badCases {
+		var d date.Date
+		err := d.Scan(c)
+		if err == nil {
+			t.Errorf("Scan(%v) == %v, want error", c, d)
+		}
+	}
+}
+
+func TestSQLEpochDate(t *testing.T) {
+	db, err := sql.Open("date-echo", "")
+	if err != nil {
+		t.Fatalf("sql.Open error %v", err)
+	}
+	defer db.Close()
+
+	cases := []struct {
+		value int64
+		want  date.Date
+	}{
+		{0, date.New(1970, time.January, 1)},
+		{16627, date.New(2015, time.July, 11)},
+		{-1, date.New(1969, time.December, 31)},
+		{math.MaxInt32, date.Max()},
+		{math.MinInt32, date.Min()},
+	}
+	for _, c := range cases {
+		var e date.EpochDate
+		err := db.QueryRow("SELECT ?", c.value).Scan(&e)
+		if err != nil {
+			t.Errorf("SQL(%v) error %v", c.value, err)
+		} else if date.Date(e) != c.want {
+			t.Errorf("SQL(%v) == %v, want %v", c.value, date.Date(e), c.want)
+		}
+		var n int64
+		err = db.QueryRow("SELECT ?", date.EpochDate(c.want)).Scan(&n)
+		if err != nil {
+			t.Errorf("SQL(%v) error %v", c.want, err)
+		} else if n != c.value {
+			t.Errorf("SQL(%v) == %v, want %v", c.want, n, c.value)
+		}
+	}
+
+	// Other values are scanned as by Date.Scan
+	var e date.EpochDate
+	if err := e.Scan("2015-07-11"); err != nil || date.Date(e) != date.New(2015, time.July, 11) {
+		t.Errorf("Scan(2015-07-11) == %v (%v), want 2015-07-11", date.Date(e), err)
+	}
+
+	badCases := []interface{}{
+		int64(math.MaxInt32) + 1,
+		int64(math.MinInt32) - 1,
+		3.14,
+		"not-a-date",
+	}
+	for _, c := range badCases {
+		var e date.EpochDate
+		err := db.QueryRow("SELECT ?", c).Scan(&e)
+		if err == nil {
+			t.Errorf("SQL(%v) == %v, want error", c, date.Date(e))
+		}
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright 2015 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"fmt"
+	"time"
+)
+
+// A Unit specifies a calendar period used to group dates (e.g. by Truncate).
+type Unit int
+
+// These are the supported units.
+const (
+	Week Unit = iota
+	Month
+	Quarter
+	Year
+)
+
+var unitNames = [...]string{"Week", "Month", "Quarter", "Year"}
+
+// String returns the English name of the unit ("Week", "Month", ...).
+func (u Unit) String() string {
+	if Week <= u && u <= Year {
+		return unitNames[u]
+	}
+	return fmt.Sprintf("%%!Unit(%d)", int(u))
+}
+
+// Truncate returns the first day of the period of the given unit containing
+// d; weeks start on Monday as in ISO 8601 (use StartOfWeek for weeks
+// starting on other days). Truncate panics if the unit is not valid.
+func (d Date) Truncate(unit Unit) Date {
+	switch unit {
+	case Week:
+		return d.StartOfWeek(time.Monday)
+	case Month:
+		return d.FirstDayOfMonth()
+	case Quarter:
+		return d.FirstDayOfQuarter()
+	case Year:
+		return New(d.Year(), time.January, 1)
+	}
+	panic("date.Truncate: invalid unit " + unit.String())
+}
@@ -0,0 +1,64 @@
+// Copyright 2015 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fxtlabs/date"
+)
+
+func TestTruncate(t *testing.T) {
+	cases := []struct {
+		value date.Date
+		unit  date.Unit
+		want  date.Date
+	}{
+		{date.New(2015, time.July, 11), date.Week, date.New(2015, time.July, 6)},
+		{date.New(2015, time.July, 6), date.Week, date.New(2015, time.July, 6)},
+		{date.New(2015, time.July, 12), date.Week, date.New(2015, time.July, 6)},
+		{date.New(2016, time.January, 1), date.Week, date.New(2015, time.December, 28)},
+		{date.New(2015, time.July, 11), date.Month, date.New(2015, time.July, 1)},
+		{date.New(2015, time.July, 1), date.Month, date.New(2015, time.July, 1)},
+		{date.New(2015, time.July, 31), date.Month, date.New(2015, time.July, 1)},
+		{date.New(2015, time.July, 11), date.Quarter, date.New(2015, time.July, 1)},
+		{date.New(2015, time.June, 30), date.Quarter, date.New(2015, time.April, 1)},
+		{date.New(2015, time.March, 31), date.Quarter, date.New(2015, time.January, 1)},
+		{date.New(2015, time.July, 11), date.Year, date.New(2015, time.January, 1)},
+		{date.New(2015, time.December, 31), date.Year, date.New(2015, time.January, 1)},
+		{date.New(-1, time.December, 31), date.Year, date.New(-1, time.January, 1)},
+	}
+	for _, c := range cases {
+		if d := c.value.Truncate(c.unit); d != c.want {
+			t.Errorf("Truncate(%v, %v) == %v, want %v", c.value, c.unit, d, c.want)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Truncate with an invalid unit did not panic")
+		}
+	}()
+	date.New(2015, time.July, 11).Truncate(date.Unit(42))
+}
+
+func TestUnitString(t *testing.T) {
+	cases := []struct {
+		unit date.Unit
+		want string
+	}{
+		{date.Week, "Week"},
+		{date.Month, "Month"},
+		{date.Quarter, "Quarter"},
+		{date.Year, "Year"},
+		{date.Unit(42), "%!Unit(42)"},
+	}
+	for _, c := range cases {
+		if s := c.unit.String(); s != c.want {
+			t.Errorf("String(%d) == %v, want %v", int(c.unit), s, c.want)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright 2015 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"fmt"
+	"time"
+)
+
+// DefaultWeekStart is the weekday on which weeks start for the week methods
+// that do not take it as a parameter (e.g. StartOfDefaultWeek); it defaults
+// to time.Monday as in ISO 8601. Set it to time.Sunday for US calendars.
+//
+// DefaultWeekStart is meant to be set once, during program initialization,
+// before any goroutine uses those methods; changing it while they may be
+// running concurrently is a data race.
+var DefaultWeekStart = time.Monday
+
+// daysFrom returns the number of days, in the range [0,6], from weekday
+// from forward to weekday to.
+func daysFrom(from, to time.Weekday) int {
+	return (int(to) - int(from) + 7) % 7
+}
+
+// Next returns the first date strictly after d that falls on the given
+// weekday. If d already falls on that weekday, Next returns the date one
+// week after d.
+func (d Date) Next(weekday time.Weekday) Date {
+	n := daysFrom(d.Weekday(), weekday)
+	if n == 0 {
+		n = 7
+	}
+	return d.Add(n)
+}
+
+// Previous returns the last date strictly before d that falls on the given
+// weekday. If d already falls on that weekday, Previous returns the date one
+// week before d.
+func (d Date) Previous(weekday time.Weekday) Date {
+	n := daysFrom(weekday, d.Weekday())
+	if n == 0 {
+		n = 7
+	}
+	return d.Add(-n)
+}
+
+// WeekdayOnOrAfter returns the first date on or after d that falls on the
+// given weekday. Unlike Next, it returns d itself if d already falls on
+// that weekday.
+func (d Date) WeekdayOnOrAfter(weekday time.Weekday) Date {
+	return d.Add(daysFrom(d.Weekday(), weekday))
+}
+
+// WeekdayOnOrBefore returns the last date on or before d that falls on the
+// given weekday. Unlike Previous, it returns d itself if d already falls on
+// that weekday.
+func (d Date) WeekdayOnOrBefore(weekday time.Weekday) Date {
+	return d.Add(-daysFrom(weekday, d.Weekday()))
+}
+
+// StartOfWeek returns the first day of the week containing d, given the
+// weekday on which weeks start (e.g. time.Monday as in ISO 8601, or
+// time.Sunday as in the US).
+func (d Date) StartOfWeek(weekStart time.Weekday) Date {
+	return d.Add(-daysFrom(weekStart, d.Weekday()))
+}
+
+// EndOfWeek returns the last day of the week containing d, given the
+// weekday on which weeks start.
+func (d Date) EndOfWeek(weekStart time.Weekday) Date {
+	return d.StartOfWeek(weekStart).Add(6)
+}
+
+// WeekOfMonth returns the week of its month in which d occurs, given the
+// weekday on which weeks start. Weeks are numbered from 1 and the first
+// week of the month is the one containing its first day, even if that week
+// starts in the previous month. The result ranges from 1 to 6, as a month
+// may span parts of six weeks (e.g. rows in a calendar grid).
+func (d Date) WeekOfMonth(weekStart time.Weekday) int {
+	first := d.FirstDayOfMonth()
+	return (d.Day()-1+daysFrom(weekStart, first.Weekday()))/7 + 1
+}
+
+// StartOfDefaultWeek returns the first day of the week containing d, with
+// weeks starting on DefaultWeekStart.
+func (d Date) StartOfDefaultWeek() Date {
+	return d.StartOfWeek(DefaultWeekStart)
+}
+
+// EndOfDefaultWeek returns the last day of the week containing d, with
+// weeks starting on DefaultWeekStart.
+func (d Date) EndOfDefaultWeek() Date {
+	return d.EndOfWeek(DefaultWeekStart)
+}
+
+// DefaultWeekOfMonth returns the week of its month in which d occurs, as
+// WeekOfMonth does, with weeks starting on DefaultWeekStart.
+func (d Date) DefaultWeekOfMonth() int {
+	return d.WeekOfMonth(DefaultWeekStart)
+}
+
+// NthWeekdayOfMonth returns the n-th occurrence of the given weekday in the
+// given month of the given year; n=1 gives the first occurrence, n=2 the
+// second, and so on, while n=-1 gives the last occurrence, n=-2 the one
+// before it, and so on. For example, the fourth Thursday of November 2015
+// is NthWeekdayOfMonth(2015, time.November, time.Thursday, 4).
+//
+// An error is returned if n is zero or if the month does not have that many
+// occurrences of the weekday (e.g. the fifth Monday of a month with only
+// four Mondays).
+func NthWeekdayOfMonth(year int, month time.Month, weekday time.Weekday, n int) (Date, error) {
+	first := New(year, month, 1)
+	var d Date
+	switch {
+	case n > 0:
+		d = first.Add(daysFrom(first.Weekday(), weekday) + 7*(n-1))
+	case n < 0:
+		last := first.LastDayOfMonth()
+		d = last.Add(-daysFrom(weekday, last.Weekday()) + 7*(n+1))
+	}
+	if n == 0 || d.Year() != first.Year() || d.Month() != first.Month() {
+		return Date{}, fmt.Errorf("Date.NthWeekdayOfMonth: no occurrence %d of %v in %v %d", n, weekday, month, year)
+	}
+	return d, nil
+}
+
+// WeekdaysInMonth returns all the dates falling on the given weekday in the
+// given month of the given year, in chronological order. The result holds
+// either four or five dates.
+func WeekdaysInMonth(year int, month time.Month, weekday time.Weekday) []Date {
+	first, _ := NthWeekdayOfMonth(year, month, weekday, 1)
+	dates := make([]Date, 0, 5)
+	for d := first; d.Month() == first.Month(); d = d.Add(7) {
+		dates = append(dates, d)
+	}
+	return dates
+}
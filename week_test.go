@@ -0,0 +1,269 @@
+// Copyright 2015 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fxtlabs/date"
+)
+
+func TestNextPrevious(t *testing.T) {
+	// July 11, 2015 was a Saturday
+	d := date.New(2015, time.July, 11)
+	cases := []struct {
+		weekday        time.Weekday
+		next, previous date.Date
+	}{
+		{time.Sunday, date.New(2015, time.July, 12), date.New(2015, time.July, 5)},
+		{time.Monday, date.New(2015, time.July, 13), date.New(2015, time.July, 6)},
+		{time.Friday, date.New(2015, time.July, 17), date.New(2015, time.July, 10)},
+		{time.Saturday, date.New(2015, time.July, 18), date.New(2015, time.July, 4)},
+	}
+	for _, c := range cases {
+		if u := d.Next(c.weekday); u != c.next {
+			t.Errorf("Next(%v, %v) == %v, want %v", d, c.weekday, u, c.next)
+		}
+		if u := d.Previous(c.weekday); u != c.previous {
+			t.Errorf("Previous(%v, %v) == %v, want %v", d, c.weekday, u, c.previous)
+		}
+	}
+
+	// Check every combination of starting and target weekday
+	for i := 0; i < 7; i++ {
+		u := d.Add(i)
+		for wd := time.Sunday; wd <= time.Saturday; wd++ {
+			next := u.Next(wd)
+			if next.Weekday() != wd || next.Sub(u) < 1 || next.Sub(u) > 7 {
+				t.Errorf("Next(%v, %v) == %v", u, wd, next)
+			}
+			previous := u.Previous(wd)
+			if previous.Weekday() != wd || u.Sub(previous) < 1 || u.Sub(previous) > 7 {
+				t.Errorf("Previous(%v, %v) == %v", u, wd, previous)
+			}
+		}
+	}
+}
+
+func TestWeekdayOnOrAfterBefore(t *testing.T) {
+	// July 11, 2015 was a Saturday
+	d := date.New(2015, time.July, 11)
+	cases := []struct {
+		weekday       time.Weekday
+		after, before date.Date
+	}{
+		{time.Sunday, date.New(2015, time.July, 12), date.New(2015, time.July, 5)},
+		{time.Monday, date.New(2015, time.July, 13), date.New(2015, time.July, 6)},
+		{time.Friday, date.New(2015, time.July, 17), date.New(2015, time.July, 10)},
+		{time.Saturday, date.New(2015, time.July, 11), date.New(2015, time.July, 11)},
+	}
+	for _, c := range cases {
+		if u := d.WeekdayOnOrAfter(c.weekday); u != c.after {
+			t.Errorf("WeekdayOnOrAfter(%v, %v) == %v, want %v", d, c.weekday, u, c.after)
+		}
+		if u := d.WeekdayOnOrBefore(c.weekday); u != c.before {
+			t.Errorf("WeekdayOnOrBefore(%v, %v) == %v, want %v", d, c.weekday, u, c.before)
+		}
+	}
+
+	// Check every combination of starting and target weekday
+	for i := 0; i < 7; i++ {
+		u := d.Add(i)
+		for wd := time.Sunday; wd <= time.Saturday; wd++ {
+			after := u.WeekdayOnOrAfter(wd)
+			if after.Weekday() != wd || after.Sub(u) < 0 || after.Sub(u) > 6 {
+				t.Errorf("WeekdayOnOrAfter(%v, %v) == %v", u, wd, after)
+			}
+			if wd != u.Weekday() && after != u.Next(wd) {
+				t.Errorf("WeekdayOnOrAfter(%v, %v) == %v, want %v", u, wd, after, u.Next(wd))
+			}
+			before := u.WeekdayOnOrBefore(wd)
+			if before.Weekday() != wd || u.Sub(before) < 0 || u.Sub(before) > 6 {
+				t.Errorf("WeekdayOnOrBefore(%v, %v) == %v", u, wd, before)
+			}
+			if wd != u.Weekday() && before != u.Previous(wd) {
+				t.Errorf("WeekdayOnOrBefore(%v, %v) == %v, want %v", u, wd, before, u.Previous(wd))
+			}
+		}
+	}
+}
+
+func TestDefaultWeekStart(t *testing.T) {
+	defer func(weekStart time.Weekday) {
+		date.DefaultWeekStart = weekStart
+	}(date.DefaultWeekStart)
+
+	if date.DefaultWeekStart != time.Monday {
+		t.Errorf("DefaultWeekStart == %v, want %v", date.DefaultWeekStart, time.Monday)
+	}
+	// August 1, 2015 was a Saturday
+	d := date.New(2015, time.August, 9)
+	cases := []struct {
+		weekStart  time.Weekday
+		start, end date.Date
+		week       int
+	}{
+		{time.Monday, date.New(2015, time.August, 3), date.New(2015, time.August, 9), 2},
+		{time.Sunday, date.New(2015, time.August, 9), date.New(2015, time.August, 15), 3},
+		{time.Saturday, date.New(2015, time.August, 8), date.New(2015, time.August, 14), 2},
+	}
+	for _, c := range cases {
+		date.DefaultWeekStart = c.weekStart
+		if u := d.StartOfDefaultWeek(); u != c.start || u != d.StartOfWeek(c.weekStart) {
+			t.Errorf("StartOfDefaultWeek(%v) with %v == %v, want %v", d, c.weekStart, u, c.start)
+		}
+		if u := d.EndOfDefaultWeek(); u != c.end || u != d.EndOfWeek(c.weekStart) {
+			t.Errorf("EndOfDefaultWeek(%v) with %v == %v, want %v", d, c.weekStart, u, c.end)
+		}
+		if n := d.DefaultWeekOfMonth(); n != c.week || n != d.WeekOfMonth(c.weekStart) {
+			t.Errorf("DefaultWeekOfMonth(%v) with %v == %v, want %v", d, c.weekStart, n, c.week)
+		}
+	}
+}
+
+func TestNthWeekdayOfMonth(t *testing.T) {
+	cases := []struct {
+		year    int
+		month   time.Month
+		weekday time.Weekday
+		n       int
+		want    date.Date
+	}{
+		{2015, time.November, time.Thursday, 4, date.New(2015, time.November, 26)},
+		{2015, time.November, time.Thursday, 1, date.New(2015, time.November, 5)},
+		{2015, time.November, time.Sunday, 1, date.New(2015, time.November, 1)},
+		{2015, time.November, time.Sunday, 5, date.New(2015, time.November, 29)},
+		{2015, time.November, time.Monday, 5, date.New(2015, time.November, 30)},
+		{2015, time.November, time.Monday, -1, date.New(2015, time.November, 30)},
+		{2015, time.November, time.Sunday, -1, date.New(2015, time.November, 29)},
+		{2015, time.November, time.Thursday, -1, date.New(2015, time.November, 26)},
+		{2015, time.November, time.Thursday, -4, date.New(2015, time.November, 5)},
+		{2015, time.May, time.Monday, -1, date.New(2015, time.May, 25)},
+		{2015, time.February, time.Saturday, -1, date.New(2015, time.February, 28)},
+		{2016, time.February, time.Monday, -1, date.New(2016, time.February, 29)},
+		{2016, time.February, time.Monday, 5, date.New(2016, time.February, 29)},
+		{2015, time.December, time.Thursday, -1, date.New(2015, time.December, 31)},
+	}
+	for _, c := range cases {
+		d, err := date.NthWeekdayOfMonth(c.year, c.month, c.weekday, c.n)
+		if err != nil {
+			t.Errorf("NthWeekdayOfMonth(%v, %v, %v, %v) error %v", c.year, c.month, c.weekday, c.n, err)
+		} else if d != c.want {
+			t.Errorf("NthWeekdayOfMonth(%v, %v, %v, %v) == %v, want %v", c.year, c.month, c.weekday, c.n, d, c.want)
+		}
+	}
+
+	badCases := []struct {
+		year    int
+		month   time.Month
+		weekday time.Weekday
+		n       int
+	}{
+		{2015, time.November, time.Thursday, 0},
+		{2015, time.November, time.Thursday, 5},
+		{2015, time.November, time.Thursday, -5},
+		{2015, time.February, time.Monday, 5},
+		{2015, time.February, time.Monday, -5},
+		{2015, time.December, time.Friday, 5},
+	}
+	for _, c := range badCases {
+		d, err := date.NthWeekdayOfMonth(c.year, c.month, c.weekday, c.n)
+		if err == nil {
+			t.Errorf("NthWeekdayOfMonth(%v, %v, %v, %v) == %v, want error", c.year, c.month, c.weekday, c.n, d)
+		}
+	}
+}
+
+func TestStartEndOfWeek(t *testing.T) {
+	cases := []struct {
+		value      date.Date
+		weekStart  time.Weekday
+		start, end date.Date
+	}{
+		// January 1, 2016 was a Friday
+		{date.New(2016, time.January, 1), time.Monday, date.New(2015, time.December, 28), date.New(2016, time.January, 3)},
+		{date.New(2016, time.January, 1), time.Sunday, date.New(2015, time.December, 27), date.New(2016, time.January, 2)},
+		{date.New(2016, time.January, 1), time.Friday, date.New(2016, time.January, 1), date.New(2016, time.January, 7)},
+		{date.New(2016, time.January, 1), time.Saturday, date.New(2015, time.December, 26), date.New(2016, time.January, 1)},
+		{date.New(2015, time.July, 12), time.Monday, date.New(2015, time.July, 6), date.New(2015, time.July, 12)},
+		{date.New(2015, time.July, 12), time.Sunday, date.New(2015, time.July, 12), date.New(2015, time.July, 18)},
+		{date.New(2015, time.July, 31), time.Monday, date.New(2015, time.July, 27), date.New(2015, time.August, 2)},
+	}
+	for _, c := range cases {
+		if d := c.value.StartOfWeek(c.weekStart); d != c.start {
+			t.Errorf("StartOfWeek(%v, %v) == %v, want %v", c.value, c.weekStart, d, c.start)
+		}
+		if d := c.value.EndOfWeek(c.weekStart); d != c.end {
+			t.Errorf("EndOfWeek(%v, %v) == %v, want %v", c.value, c.weekStart, d, c.end)
+		}
+	}
+}
+
+func TestWeekOfMonth(t *testing.T) {
+	cases := []struct {
+		value     date.Date
+		weekStart time.Weekday
+		want      int
+	}{
+		// June 2015 started on a Monday
+		{date.New(2015, time.June, 1), time.Monday, 1},
+		{date.New(2015, time.June, 7), time.Monday, 1},
+		{date.New(2015, time.June, 8), time.Monday, 2},
+		{date.New(2015, time.June, 30), time.Monday, 5},
+		{date.New(2015, time.June, 1), time.Sunday, 1},
+		{date.New(2015, time.June, 6), time.Sunday, 1},
+		{date.New(2015, time.June, 7), time.Sunday, 2},
+		// August 2015 started on a Saturday and spans six Monday weeks
+		{date.New(2015, time.August, 1), time.Monday, 1},
+		{date.New(2015, time.August, 2), time.Monday, 1},
+		{date.New(2015, time.August, 3), time.Monday, 2},
+		{date.New(2015, time.August, 30), time.Monday, 5},
+		{date.New(2015, time.August, 31), time.Monday, 6},
+		{date.New(2015, time.August, 1), time.Sunday, 1},
+		{date.New(2015, time.August, 2), time.Sunday, 2},
+		{date.New(2015, time.August, 31), time.Sunday, 6},
+		// February 2015 started on a Sunday and fits in four Sunday weeks
+		{date.New(2015, time.February, 1), time.Sunday, 1},
+		{date.New(2015, time.February, 28), time.Sunday, 4},
+		{date.New(2015, time.February, 1), time.Monday, 1},
+		{date.New(2015, time.February, 2), time.Monday, 2},
+		{date.New(2015, time.February, 28), time.Monday, 5},
+	}
+	for _, c := range cases {
+		if n := c.value.WeekOfMonth(c.weekStart); n != c.want {
+			t.Errorf("WeekOfMonth(%v, %v) == %v, want %v", c.value, c.weekStart, n, c.want)
+		}
+	}
+}
+
+func TestWeekdaysInMonth(t *testing.T) {
+	cases := []struct {
+		year    int
+		month   time.Month
+		weekday time.Weekday
+		want    []int
+	}{
+		{2015, time.March, time.Tuesday, []int{3, 10, 17, 24, 31}},
+		{2015, time.March, time.Wednesday, []int{4, 11, 18, 25}},
+		{2015, time.March, time.Sunday, []int{1, 8, 15, 22, 29}},
+		{2015, time.February, time.Sunday, []int{1, 8, 15, 22}},
+		{2015, time.February, time.Saturday, []int{7, 14, 21, 28}},
+		{2016, time.February, time.Monday, []int{1, 8, 15, 22, 29}},
+		{2015, time.December, time.Thursday, []int{3, 10, 17, 24, 31}},
+	}
+	for _, c := range cases {
+		dates := date.WeekdaysInMonth(c.year, c.month, c.weekday)
+		if len(dates) != len(c.want) {
+			t.Errorf("WeekdaysInMonth(%v, %v, %v) == %v, want days %v", c.year, c.month, c.weekday, dates, c.want)
+			continue
+		}
+		for i, d := range dates {
+			if d != date.New(c.year, c.month, c.want[i]) {
+				t.Errorf("WeekdaysInMonth(%v, %v, %v)[%d] == %v, want day %v", c.year, c.month, c.weekday, i, d, c.want[i])
+			}
+		}
+	}
+}